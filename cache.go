@@ -0,0 +1,219 @@
+package gocomfy
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+)
+
+// CachedResults are outputs of the prompt, keyed by canonical hashes of the nodes.
+// Unlike Results, they do not depend on the node numbering in the graph.
+type CachedResults map[apigraph.Hash]NodeResult
+
+// ResultCache stores results of previously executed prompts, keyed by the canonical graph hash.
+//
+// Results only contain references to files on the server, thus the cache is only valid while the server keeps the outputs.
+type ResultCache interface {
+	// GetResults returns cached results for the graph hash. It returns false if the results are not in the cache.
+	GetResults(ctx context.Context, key apigraph.Hash) (CachedResults, bool, error)
+	// PutResults stores results for the graph hash.
+	PutResults(ctx context.Context, key apigraph.Hash, res CachedResults) error
+}
+
+// WithResultCache enables result caching for RunPrompt and RunPromptJSON.
+//
+// If the graph was executed before, results are returned from the cache without queueing the prompt.
+// Results are not cached if the graph has identical output nodes, since their outputs cannot be told apart by the hash.
+func WithResultCache(cache ResultCache) ClientOption {
+	return clientOptionFunc(func(c *clientOptions) {
+		c.Cache = cache
+	})
+}
+
+// cacheKey computes the cache key and node hashes of the prompt.
+func cacheKey(prompt any) (*apigraph.Graph, apigraph.Hash, error) {
+	var (
+		g   *apigraph.Graph
+		err error
+	)
+	switch prompt := prompt.(type) {
+	case *apigraph.Graph:
+		g = prompt
+	case json.RawMessage:
+		g, err = apigraph.Unmarshal(prompt)
+	default:
+		err = fmt.Errorf("unsupported prompt type: %T", prompt)
+	}
+	if err != nil {
+		return nil, apigraph.Hash{}, err
+	}
+	key, err := g.Hash()
+	return g, key, err
+}
+
+func (c *Client) cachedResults(ctx context.Context, g *apigraph.Graph, key apigraph.Hash) (Results, bool, error) {
+	cached, ok, err := c.cache.GetResults(ctx, key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	hashes, err := g.NodeHashes()
+	if err != nil {
+		return nil, false, err
+	}
+	res := make(Results, len(cached))
+	for id, h := range hashes {
+		if r, ok := cached[h]; ok {
+			res[id] = r
+		}
+	}
+	return res, true, nil
+}
+
+func (c *Client) cacheResults(ctx context.Context, g *apigraph.Graph, key apigraph.Hash, res Results) error {
+	hashes, err := g.NodeHashes()
+	if err != nil {
+		return err
+	}
+	nodes := make(map[apigraph.Hash]int, len(hashes))
+	for _, h := range hashes {
+		nodes[h]++
+	}
+	cached := make(CachedResults, len(res))
+	for id, r := range res {
+		for _, img := range r.Files() {
+			if img.Type == ImageTemp {
+				return nil // temporary outputs are removed by the server
+			}
+		}
+		h, ok := hashes[id]
+		if !ok {
+			continue
+		}
+		if nodes[h] > 1 {
+			return nil // identical output nodes would share cached outputs
+		}
+		cached[h] = r
+	}
+	return c.cache.PutResults(ctx, key, cached)
+}
+
+// MemoryCache is an in-memory ResultCache with LRU eviction.
+type MemoryCache struct {
+	max int
+
+	mu      sync.Mutex
+	entries map[apigraph.Hash]*list.Element
+	lru     list.List
+}
+
+type memCacheEntry struct {
+	key apigraph.Hash
+	res CachedResults
+}
+
+var _ ResultCache = (*MemoryCache)(nil)
+
+// NewMemoryCache creates an in-memory cache that keeps at most max entries. Zero means no limit.
+func NewMemoryCache(max int) *MemoryCache {
+	return &MemoryCache{
+		max:     max,
+		entries: make(map[apigraph.Hash]*list.Element),
+	}
+}
+
+// Len returns the number of cached entries.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+func (c *MemoryCache) GetResults(ctx context.Context, key apigraph.Hash) (CachedResults, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.entries[key]
+	if e == nil {
+		return nil, false, nil
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*memCacheEntry).res, true, nil
+}
+
+func (c *MemoryCache) PutResults(ctx context.Context, key apigraph.Hash, res CachedResults) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e := c.entries[key]; e != nil {
+		e.Value.(*memCacheEntry).res = res
+		c.lru.MoveToFront(e)
+		return nil
+	}
+	c.entries[key] = c.lru.PushFront(&memCacheEntry{key: key, res: res})
+	if c.max > 0 && len(c.entries) > c.max {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.entries, e.Value.(*memCacheEntry).key)
+	}
+	return nil
+}
+
+// DiskCache is a ResultCache that stores results as JSON files in a directory.
+type DiskCache struct {
+	dir string
+}
+
+var _ ResultCache = (*DiskCache)(nil)
+
+// NewDiskCache creates a cache in a given directory. The directory is created if it doesn't exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) path(key apigraph.Hash) string {
+	return filepath.Join(c.dir, key.String()+".json")
+}
+
+func (c *DiskCache) GetResults(ctx context.Context, key apigraph.Hash) (CachedResults, bool, error) {
+	data, err := os.ReadFile(c.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	var res CachedResults
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, false, fmt.Errorf("cannot decode cache entry %v: %w", key, err)
+	}
+	return res, true, nil
+}
+
+func (c *DiskCache) PutResults(ctx context.Context, key apigraph.Hash, res CachedResults) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that concurrent readers never see a partial entry.
+	f, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.path(key))
+}
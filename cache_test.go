@@ -0,0 +1,56 @@
+package gocomfy
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestResultCache(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	disk, err := NewDiskCache(t.TempDir())
+	must.NoError(t, err)
+	for _, cache := range []ResultCache{NewMemoryCache(10), disk} {
+		var prompts atomic.Int32
+		base := fakeComfy(t, 0)
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /prompt", func(w http.ResponseWriter, r *http.Request) {
+			prompts.Add(1)
+			base.ServeHTTP(w, r)
+		})
+		mux.Handle("/", base)
+		c := fakeClient(t, mux, WithResultCache(cache), WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+		res, err := c.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"B","inputs":{"seed":1}}}`))
+		must.NoError(t, err)
+		must.EqOp(t, "a.png", res[9].Images[0].Filename)
+		must.EqOp(t, 1, prompts.Load())
+
+		// Same graph with different numbering is served from the cache.
+		res, err = c.RunPromptJSON(ctx, []byte(`{"5":{"class_type":"B","inputs":{"seed":1},"_meta":{"title":"X"}}}`))
+		must.NoError(t, err)
+		must.EqOp(t, "a.png", res[5].Images[0].Filename)
+		must.EqOp(t, 1, prompts.Load())
+
+		// Different seed is not.
+		_, err = c.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"B","inputs":{"seed":2}}}`))
+		must.NoError(t, err)
+		must.EqOp(t, 2, prompts.Load())
+
+		// Identical output nodes are not cached, since their outputs would be mixed up.
+		dup := []byte(`{"9":{"class_type":"B","inputs":{"seed":3}},"12":{"class_type":"B","inputs":{"seed":3}}}`)
+		for range 2 {
+			res, err = c.RunPromptJSON(ctx, dup)
+			must.NoError(t, err)
+			must.EqOp(t, "a.png", res[9].Images[0].Filename)
+			must.EqOp(t, "b.png", res[12].Images[0].Filename)
+		}
+		must.EqOp(t, 4, prompts.Load())
+	}
+}
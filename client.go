@@ -11,6 +11,8 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/dennwc/gocomfy/wsconn"
 	"github.com/google/uuid"
@@ -22,6 +24,12 @@ type clientOptions struct {
 	WSOptions   []wsconn.DialOption
 	HTTPClient  *http.Client
 	OnQueueSize func(queue int)
+
+	PollInterval    time.Duration
+	PollMaxInterval time.Duration
+
+	Caps  *Capabilities
+	Cache ResultCache
 }
 
 type ClientOption interface {
@@ -52,6 +60,9 @@ func WithOnQueueSize(fnc func(queue int)) ClientOption {
 	})
 }
 
+// WithoutWebsocket disables the websocket connection.
+//
+// Prompt events are emulated by polling the server, see WithPollInterval.
 func WithoutWebsocket() ClientOption {
 	return clientOptionFunc(func(c *clientOptions) {
 		c.NoWebSocket = true
@@ -69,6 +80,13 @@ func NewClient(ctx context.Context, host string, opts ...ClientOption) (*Client,
 	if opt.HTTPClient == nil {
 		opt.HTTPClient = http.DefaultClient
 	}
+	if opt.PollInterval <= 0 {
+		opt.PollInterval = defaultPollInterval
+	}
+	if opt.PollMaxInterval <= 0 {
+		opt.PollMaxInterval = defaultPollMaxInterval
+	}
+	opt.PollMaxInterval = max(opt.PollInterval, opt.PollMaxInterval)
 
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -76,23 +94,34 @@ func NewClient(ctx context.Context, host string, opts ...ClientOption) (*Client,
 	}
 	sid := id.String()
 	opt.Log = opt.Log.With("clientId", sid)
-	var conn *wsconn.Conn
-	if !opt.NoWebSocket {
-		conn, err = wsconn.Dial(ctx, host, sid, opt.WSOptions...)
-		if err != nil {
-			return nil, err
-		}
-	}
 	c := &Client{
 		id:          sid,
 		host:        host,
 		log:         opt.Log,
-		conn:        conn,
 		hcli:        opt.HTTPClient,
 		prompts:     make(map[string]*Prompt),
 		onQueueSize: opt.OnQueueSize,
+
+		pollInterval:    opt.PollInterval,
+		pollMaxInterval: opt.PollMaxInterval,
+		cache:           opt.Cache,
 	}
+	c.queue.Store(-1)
+	c.caps = opt.Caps
 	if !opt.NoWebSocket {
+		// Feature flags are sent via the websocket, thus capabilities must be known before connecting.
+		caps, err := c.Capabilities(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.conn, err = wsconn.Dial(ctx, host, sid, opt.WSOptions...)
+		if err != nil {
+			return nil, err
+		}
+		if err = c.sendFeatureFlags(caps); err != nil {
+			_ = c.conn.Close()
+			return nil, err
+		}
 		go c.readEvents()
 	}
 	return c, nil
@@ -106,15 +135,41 @@ type Client struct {
 
 	onQueueSize func(queue int)
 
+	pollInterval    time.Duration
+	pollMaxInterval time.Duration
+
+	capsMu sync.Mutex
+	caps   *Capabilities
+	cache  ResultCache
+
+	// logSubMu serializes log subscription requests, logSubbed is the subscription state on the server.
+	logSubMu  sync.Mutex
+	logSubbed bool
+
+	queue atomic.Int64
+	dead  atomic.Bool
+
 	mu      sync.RWMutex
 	conn    *wsconn.Conn
 	prompts map[string]*Prompt
+	logSubs map[chan LogEntry]chan struct{}
 }
 
 func (c *Client) ID() string {
 	return c.id
 }
 
+// QueueSize returns the number of prompts in the server queue, as last reported by the server.
+// It returns -1 if the queue size is unknown, for example when the websocket is disabled.
+func (c *Client) QueueSize() int {
+	return int(c.queue.Load())
+}
+
+// Alive reports whether the client is usable. It returns false after the websocket connection drops or the client is closed.
+func (c *Client) Alive() bool {
+	return !c.dead.Load()
+}
+
 func (c *Client) killPrompts() {
 	c.mu.Lock()
 	prompts := c.prompts
@@ -126,7 +181,9 @@ func (c *Client) killPrompts() {
 }
 
 func (c *Client) Close() {
+	c.dead.Store(true)
 	c.killPrompts()
+	c.closeLogSubs()
 	if c.conn != nil {
 		_ = c.conn.Close()
 	}
@@ -145,7 +202,9 @@ func (c *Client) delPrompt(pid string) {
 }
 
 func (c *Client) readEvents() {
+	defer c.closeLogSubs()
 	defer c.killPrompts()
+	defer c.dead.Store(true)
 	for {
 		m, err := c.conn.ReadMsg()
 		if errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF) {
@@ -175,20 +234,38 @@ func (c *Client) procClientEvent(log *slog.Logger, ev wsconn.Event) {
 	switch ev := ev.(type) {
 	case *wsconn.StatusEvent:
 		c.procStatus(log, ev)
+	case *wsconn.LogsEvent:
+		c.procLogs(log, ev)
 	default:
 		log.Debug("unknown client event")
 	}
 }
 
 func (c *Client) procStatus(log *slog.Logger, ev *wsconn.StatusEvent) {
-	if c.onQueueSize == nil {
+	q := ev.Status.Exec.Queue
+	if q == nil {
 		return
 	}
-	if q := ev.Status.Exec.Queue; q != nil {
+	c.queue.Store(int64(*q))
+	if c.onQueueSize != nil {
 		c.onQueueSize(*q)
 	}
 }
 
+// StatusError is returned when the server responds with an unexpected HTTP status code.
+type StatusError struct {
+	Code int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.Code)
+}
+
+func isNotFound(err error) bool {
+	var e *StatusError
+	return errors.As(err, &e) && e.Code == http.StatusNotFound
+}
+
 func (c *Client) get(ctx context.Context, path string) (io.ReadCloser, string, error) {
 	addr := fmt.Sprintf("http://%s%s", c.host, path)
 	req, err := http.NewRequestWithContext(ctx, "GET", addr, nil)
@@ -201,7 +278,7 @@ func (c *Client) get(ctx context.Context, path string) (io.ReadCloser, string, e
 	}
 	if resp.StatusCode != http.StatusOK {
 		_ = resp.Body.Close()
-		return nil, "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, "", &StatusError{Code: resp.StatusCode}
 	}
 	return resp.Body, resp.Header.Get("Content-Type"), nil
 }
@@ -216,24 +293,34 @@ func (c *Client) getJSON(ctx context.Context, path string, out any) error {
 }
 
 func (c *Client) postJSON(ctx context.Context, path string, data, out any) error {
-	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(data)
-	if err != nil {
-		return err
+	return c.sendJSON(ctx, "POST", path, data, out)
+}
+
+func (c *Client) sendJSON(ctx context.Context, method, path string, data, out any) error {
+	var body io.Reader
+	if data != nil {
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(data)
+		if err != nil {
+			return err
+		}
+		body = &buf
 	}
 	addr := fmt.Sprintf("http://%s%s", c.host, path)
-	req, err := http.NewRequestWithContext(ctx, "POST", addr, &buf)
+	req, err := http.NewRequestWithContext(ctx, method, addr, body)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	resp, err := c.hcli.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &StatusError{Code: resp.StatusCode}
 	}
 	if out == nil {
 		return nil
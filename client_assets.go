@@ -0,0 +1,240 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type ListAssetsOpts struct {
+	Offset int
+	Limit  int
+
+	// IncludeTags only returns assets that have all the given tags.
+	IncludeTags []string
+	// ExcludeTags only returns assets that have none of the given tags.
+	ExcludeTags []string
+	// NameContains only returns assets with names containing a given substring.
+	NameContains string
+	// Sort is a field name to sort assets by (e.g. "name", "created_at", "size").
+	Sort string
+	// Desc sets descending sort order.
+	Desc bool
+}
+
+func (opts *ListAssetsOpts) setURL(qu url.Values) {
+	qu.Set("offset", strconv.Itoa(opts.Offset))
+	qu.Set("limit", strconv.Itoa(opts.Limit))
+	if len(opts.IncludeTags) != 0 {
+		qu.Set("include_tags", strings.Join(opts.IncludeTags, ","))
+	}
+	if len(opts.ExcludeTags) != 0 {
+		qu.Set("exclude_tags", strings.Join(opts.ExcludeTags, ","))
+	}
+	if opts.NameContains != "" {
+		qu.Set("name_contains", opts.NameContains)
+	}
+	if opts.Sort != "" {
+		qu.Set("sort", opts.Sort)
+		if opts.Desc {
+			qu.Set("order", "desc")
+		} else {
+			qu.Set("order", "asc")
+		}
+	}
+}
+
+type Asset struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Hash       string         `json:"asset_hash"`
+	Size       int64          `json:"size"`
+	Mime       string         `json:"mime_type"`
+	Tags       []string       `json:"tags"`
+	UserMeta   map[string]any `json:"user_metadata"`
+	PreviewID  string         `json:"preview_id"`
+	PreviewURL string         `json:"preview_url"`
+	PromptID   string         `json:"prompt_id"`
+}
+
+// HasTag checks if the asset has a given tag.
+func (a *Asset) HasTag(tag string) bool {
+	for _, t := range a.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Ref returns a file reference for an input or output asset. It can be passed to loader nodes via ImageRef.AnnotatedPath.
+//
+// It returns false for assets that are not stored in input or output directories (e.g. models).
+func (a *Asset) Ref() (ImageRef, bool) {
+	var typ ImageType
+	switch {
+	case a.HasTag(string(ImageInput)):
+		typ = ImageInput
+	case a.HasTag(string(ImageOutput)):
+		typ = ImageOutput
+	case a.HasTag(string(ImageTemp)):
+		typ = ImageTemp
+	default:
+		return ImageRef{}, false
+	}
+	dir, file := path.Split(a.Name)
+	return ImageRef{
+		Filename:  file,
+		Subfolder: strings.TrimSuffix(dir, "/"),
+		Type:      typ,
+	}, true
+}
+
+func (c *Client) ListAssetsPage(ctx context.Context, opts *ListAssetsOpts) ([]Asset, error) {
+	if opts == nil {
+		opts = &ListAssetsOpts{}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+	qu := make(url.Values)
+	opts.setURL(qu)
+
+	var out struct {
+		Items []Asset `json:"assets"`
+	}
+	err := c.getJSON(ctx, "/api/assets?"+qu.Encode(), &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Items, nil
+}
+
+func (c *Client) ListAssetsSeq(ctx context.Context, opts *ListAssetsOpts) iter.Seq2[Asset, error] {
+	return func(yield func(Asset, error) bool) {
+		if opts == nil {
+			opts = &ListAssetsOpts{}
+		}
+		for {
+			list, err := c.ListAssetsPage(ctx, opts)
+			if err != nil {
+				yield(Asset{}, err)
+				return
+			}
+			if len(list) == 0 {
+				return
+			}
+			opts.Offset += len(list)
+			for _, a := range list {
+				if !yield(a, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func assetPath(id string) string {
+	return "/api/assets/" + url.PathEscape(id)
+}
+
+// GetAsset returns an asset by ID.
+func (c *Client) GetAsset(ctx context.Context, id string) (*Asset, error) {
+	var out Asset
+	if err := c.getJSON(ctx, assetPath(id), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAssetContent downloads the asset content. It returns the reader and the content type.
+func (c *Client) GetAssetContent(ctx context.Context, id string) (io.ReadCloser, string, error) {
+	return c.get(ctx, assetPath(id)+"/content")
+}
+
+// HasAssetHash checks if the server has an asset with a given content hash.
+func (c *Client) HasAssetHash(ctx context.Context, hash string) (bool, error) {
+	err := c.sendJSON(ctx, "HEAD", "/api/assets/hash/"+url.PathEscape(hash), nil, nil)
+	if isNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type AssetUpload struct {
+	Name     string
+	Tags     []string
+	UserMeta map[string]any
+}
+
+// UploadAsset uploads a new asset with given tags and user metadata.
+func (c *Client) UploadAsset(ctx context.Context, info AssetUpload, r io.Reader, opts ...UploadOption) (*Asset, error) {
+	opt := uploadOptions{Size: -1}
+	for _, o := range opts {
+		o.applyToUpload(&opt)
+	}
+	var fields []formField
+	if info.Name != "" {
+		fields = append(fields, formField{"name", info.Name})
+	}
+	for _, tag := range info.Tags {
+		fields = append(fields, formField{"tags", tag})
+	}
+	if info.UserMeta != nil {
+		data, err := json.Marshal(info.UserMeta)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, formField{"user_metadata", string(data)})
+	}
+	var out Asset
+	if err := c.postFile(ctx, "/api/assets", fields, "file", info.Name, r, &opt, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type AssetUpdate struct {
+	Name     *string        `json:"name,omitempty"`
+	Tags     []string       `json:"tags,omitempty"`
+	UserMeta map[string]any `json:"user_metadata,omitempty"`
+}
+
+// UpdateAsset updates asset name, tags or user metadata. Only non-empty fields are updated.
+func (c *Client) UpdateAsset(ctx context.Context, id string, upd AssetUpdate) (*Asset, error) {
+	var out Asset
+	if err := c.sendJSON(ctx, "PUT", assetPath(id), upd, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddAssetTags adds tags to the asset.
+func (c *Client) AddAssetTags(ctx context.Context, id string, tags ...string) error {
+	return c.sendJSON(ctx, "POST", assetPath(id)+"/tags", struct {
+		Tags []string `json:"tags"`
+	}{
+		Tags: tags,
+	}, nil)
+}
+
+// RemoveAssetTags removes tags from the asset.
+func (c *Client) RemoveAssetTags(ctx context.Context, id string, tags ...string) error {
+	return c.sendJSON(ctx, "DELETE", assetPath(id)+"/tags", struct {
+		Tags []string `json:"tags"`
+	}{
+		Tags: tags,
+	}, nil)
+}
+
+// DeleteAsset deletes the asset.
+func (c *Client) DeleteAsset(ctx context.Context, id string) error {
+	return c.sendJSON(ctx, "DELETE", assetPath(id), nil, nil)
+}
@@ -0,0 +1,36 @@
+package gocomfy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestListAssets(t *testing.T) {
+	c := fakeClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		must.EqOp(t, "/api/assets", r.URL.Path)
+		must.EqOp(t, "input,image", r.URL.Query().Get("include_tags"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("offset") != "0" {
+			_, _ = w.Write([]byte(`{"assets":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"assets":[
+			{"id":"a1","name":"sub/cat.png","mime_type":"image/png","tags":["input","image"],"user_metadata":{"k":"v"}}
+		]}`))
+	}))
+	var list []Asset
+	for a, err := range c.ListAssetsSeq(context.Background(), &ListAssetsOpts{IncludeTags: []string{"input", "image"}}) {
+		must.NoError(t, err)
+		list = append(list, a)
+	}
+	must.Len(t, 1, list)
+	a := list[0]
+	must.EqOp(t, "image/png", a.Mime)
+	must.Eq(t, map[string]any{"k": "v"}, a.UserMeta)
+	ref, ok := a.Ref()
+	must.True(t, ok)
+	must.EqOp(t, "sub/cat.png [input]", ref.AnnotatedPath())
+}
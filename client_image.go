@@ -1,20 +1,18 @@
 package gocomfy
 
 import (
+	"bufio"
 	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
 	"image"
+	"image/draw"
+	"image/gif"
 	"image/jpeg"
 	"image/png"
 	"io"
-	"iter"
-	"mime/multipart"
-	"net/http"
-	"net/textproto"
 	"net/url"
-	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dennwc/gocomfy/types"
 )
@@ -35,143 +33,158 @@ func (c *Client) GetImageFile(ctx context.Context, ref ImageRef) (io.ReadCloser,
 	return c.get(ctx, "/view?"+vals.Encode())
 }
 
+// GetImage downloads and decodes an image. PNG, JPEG, GIF and WebP formats are supported.
+// For animated images, only the first frame is returned. Use GetImageFrames to get all frames.
 func (c *Client) GetImage(ctx context.Context, ref ImageRef) (image.Image, error) {
 	rc, typ, err := c.GetImageFile(ctx, ref)
 	if err != nil {
 		return nil, err
 	}
 	defer rc.Close()
-	switch typ {
-	case "image/png":
-		return png.Decode(rc)
-	case "image/jpeg":
-		return jpeg.Decode(rc)
+	br := bufio.NewReader(rc)
+	switch detectImageFormat(typ, br) {
+	case imagePNG:
+		return png.Decode(br)
+	case imageJPEG:
+		return jpeg.Decode(br)
+	case imageGIF:
+		return gif.Decode(br)
+	case imageWebP:
+		data, err := io.ReadAll(br)
+		if err != nil {
+			return nil, err
+		}
+		frames, err := decodeWebPFrames(data)
+		if err != nil {
+			return nil, err
+		}
+		return frames[0].Image, nil
 	}
-	return png.Decode(rc)
+	img, _, err := image.Decode(br)
+	return img, err
 }
 
-func (c *Client) UploadImageFile(ctx context.Context, ref ImageRef, r io.Reader, overwrite bool) (*ImageRef, error) {
-	var buf bytes.Buffer
-	form := multipart.NewWriter(&buf)
-	if ref.Type != "" {
-		form.WriteField("type", string(ref.Type))
-	}
-	if ref.Subfolder != "" {
-		form.WriteField("subfolder", ref.Subfolder)
-	}
-	if overwrite {
-		form.WriteField("overwrite", "true")
-	}
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Type", "image/png")
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="image"; filename=%q`, ref.Filename))
-	fw, err := form.CreatePart(h)
-	if err != nil {
-		return nil, err
-	}
-	if _, err = io.Copy(fw, r); err != nil {
-		return nil, err
-	}
-	if err = form.Close(); err != nil {
-		return nil, err
-	}
+// Frame is a single frame of an animated image.
+type Frame struct {
+	Image image.Image
+	// Delay before displaying the next frame.
+	Delay time.Duration
+}
 
-	addr := fmt.Sprintf("http://%s/upload/image", c.host)
-	req, err := http.NewRequestWithContext(ctx, "POST", addr, &buf)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", form.FormDataContentType())
-	resp, err := c.hcli.Do(req)
+// GetImageFrames downloads and decodes all frames of an animated image (GIF or WebP).
+// Still images are returned as a single frame.
+//
+// Each frame is composited with the previous ones, so it can be displayed as-is.
+func (c *Client) GetImageFrames(ctx context.Context, ref ImageRef) ([]Frame, error) {
+	rc, typ, err := c.GetImageFile(ctx, ref)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-	var out struct {
-		Filename  string    `json:"name"`
-		Subfolder string    `json:"subfolder"`
-		Type      ImageType `json:"type"`
-	}
-	if err = json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return nil, err
+	defer rc.Close()
+	br := bufio.NewReader(rc)
+	switch detectImageFormat(typ, br) {
+	case imageGIF:
+		g, err := gif.DecodeAll(br)
+		if err != nil {
+			return nil, err
+		}
+		return gifFrames(g), nil
+	case imageWebP:
+		data, err := io.ReadAll(br)
+		if err != nil {
+			return nil, err
+		}
+		return decodeWebPFrames(data)
 	}
-	return &ImageRef{
-		Filename:  out.Filename,
-		Subfolder: out.Subfolder,
-		Type:      out.Type,
-	}, nil
-}
-
-func (c *Client) UploadImage(ctx context.Context, ref ImageRef, img image.Image, overwrite bool) (*ImageRef, error) {
-	var buf bytes.Buffer
-	if err := png.Encode(&buf, img); err != nil {
+	img, _, err := image.Decode(br)
+	if err != nil {
 		return nil, err
 	}
-	return c.UploadImageFile(ctx, ref, &buf, overwrite)
-}
-
-type ListAssetsOpts struct {
-	Offset int
-	Limit  int
+	return []Frame{{Image: img}}, nil
 }
 
-type Asset struct {
-	ID         string   `json:"id"`
-	Name       string   `json:"name"`
-	Hash       string   `json:"asset_hash"`
-	Size       int64    `json:"size"`
-	Mime       int64    `json:"mime_type"`
-	Tags       []string `json:"tags"`
-	UserMeta   string   `json:"user_metadata"`
-	PreviewID  string   `json:"preview_id"`
-	PreviewURL string   `json:"preview_url"`
-	PromptID   string   `json:"prompt_id"`
-}
+type imageFormat int
 
-func (c *Client) ListAssetsPage(ctx context.Context, opts *ListAssetsOpts) ([]Asset, error) {
-	if opts == nil {
-		opts = &ListAssetsOpts{}
-	}
-	if opts.Limit <= 0 {
-		opts.Limit = 20
-	}
-	qu := make(url.Values)
-	qu.Set("offset", strconv.Itoa(opts.Offset))
-	qu.Set("limit", strconv.Itoa(opts.Limit))
+const (
+	imageUnknown = imageFormat(iota)
+	imagePNG
+	imageJPEG
+	imageGIF
+	imageWebP
+)
 
-	var out struct {
-		Items []Asset `json:"assets"`
-	}
-	err := c.getJSON(ctx, "/api/assets?"+qu.Encode(), out)
-	if err != nil {
-		return nil, err
+// detectImageFormat detects image format by its magic bytes, falling back to the content type.
+func detectImageFormat(typ string, br *bufio.Reader) imageFormat {
+	head, _ := br.Peek(12)
+	switch {
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return imagePNG
+	case bytes.HasPrefix(head, []byte("\xff\xd8\xff")):
+		return imageJPEG
+	case bytes.HasPrefix(head, []byte("GIF8")):
+		return imageGIF
+	case len(head) >= 12 && string(head[0:4]) == "RIFF" && string(head[8:12]) == "WEBP":
+		return imageWebP
+	}
+	typ, _, _ = strings.Cut(typ, ";")
+	switch typ {
+	case "image/png":
+		return imagePNG
+	case "image/jpeg":
+		return imageJPEG
+	case "image/gif":
+		return imageGIF
+	case "image/webp":
+		return imageWebP
 	}
-	return out.Items, nil
+	return imageUnknown
 }
 
-func (c *Client) ListAssetsSeq(ctx context.Context, opts *ListAssetsOpts) iter.Seq2[Asset, error] {
-	return func(yield func(Asset, error) bool) {
-		if opts == nil {
-			opts = &ListAssetsOpts{}
+func gifFrames(g *gif.GIF) []Frame {
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	if bounds.Empty() && len(g.Image) != 0 {
+		bounds = g.Image[0].Bounds()
+	}
+	canvas := image.NewNRGBA(bounds)
+	frames := make([]Frame, 0, len(g.Image))
+	for i, img := range g.Image {
+		var prev *image.NRGBA
+		disposal := byte(0)
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
 		}
-		for {
-			list, err := c.ListAssetsPage(ctx, opts)
-			if err != nil {
-				yield(Asset{}, err)
-				return
-			}
-			if len(list) == 0 {
-				return
-			}
-			opts.Offset += len(list)
-			for _, a := range list {
-				if !yield(a, nil) {
-					return
-				}
-			}
+		if disposal == gif.DisposalPrevious {
+			prev = image.NewNRGBA(bounds)
+			copy(prev.Pix, canvas.Pix)
+		}
+		draw.Draw(canvas, img.Bounds(), img, img.Bounds().Min, draw.Over)
+		out := image.NewNRGBA(bounds)
+		copy(out.Pix, canvas.Pix)
+		var delay time.Duration
+		if i < len(g.Delay) {
+			delay = time.Duration(g.Delay[i]) * 10 * time.Millisecond
+		}
+		frames = append(frames, Frame{Image: out, Delay: delay})
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, img.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = prev
 		}
 	}
+	return frames
+}
+
+func (c *Client) UploadImageFile(ctx context.Context, ref ImageRef, r io.Reader, overwrite bool, opts ...UploadOption) (*ImageRef, error) {
+	return c.UploadFile(ctx, ref, r, overwrite, opts...)
+}
+
+// UploadImage encodes the image as PNG and uploads it. Image is encoded while it is being uploaded.
+func (c *Client) UploadImage(ctx context.Context, ref ImageRef, img image.Image, overwrite bool, opts ...UploadOption) (*ImageRef, error) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(png.Encode(pw, img))
+	}()
+	defer pr.Close()
+	return c.UploadImageFile(ctx, ref, pr, overwrite, opts...)
 }
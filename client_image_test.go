@@ -0,0 +1,159 @@
+package gocomfy
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+	"github.com/dennwc/gocomfy/graph/apinodes"
+)
+
+// animatedWebP builds an animated WebP file from a still VP8L image, repeating it as frames.
+func animatedWebP(t testing.TB, still []byte, frames int) []byte {
+	// RIFF header + VP8L chunk
+	must.EqOp(t, "VP8L", string(still[12:16]))
+	chunk := still[12:]
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(still))
+	must.NoError(t, err)
+
+	var buf bytes.Buffer
+	writeChunk := func(id string, data []byte) {
+		buf.WriteString(id)
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(data)))
+		buf.Write(data)
+		if len(data)%2 != 0 {
+			buf.WriteByte(0)
+		}
+	}
+	buf.WriteString("RIFF\x00\x00\x00\x00WEBP")
+	vp8x := []byte{1 << 1, 0, 0, 0}
+	vp8x = append(vp8x, putUint24(uint32(cfg.Width-1))...)
+	vp8x = append(vp8x, putUint24(uint32(cfg.Height-1))...)
+	writeChunk("VP8X", vp8x)
+	writeChunk("ANIM", []byte{0, 0, 0, 0, 0, 0})
+	for i := 0; i < frames; i++ {
+		var fr []byte
+		fr = append(fr, putUint24(0)...)
+		fr = append(fr, putUint24(0)...)
+		fr = append(fr, putUint24(uint32(cfg.Width-1))...)
+		fr = append(fr, putUint24(uint32(cfg.Height-1))...)
+		fr = append(fr, putUint24(uint32(100*(i+1)))...)
+		fr = append(fr, 0)
+		fr = append(fr, chunk...)
+		writeChunk("ANMF", fr)
+	}
+	data := buf.Bytes()
+	binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-8))
+	return data
+}
+
+func TestGetImageFrames(t *testing.T) {
+	still, err := os.ReadFile("./testdata/still.webp")
+	must.NoError(t, err)
+
+	// The first frame fills the canvas, the second one only covers the bottom-right corner and is disposed to the background,
+	// and the third one covers the top-left pixel.
+	var (
+		anim  bytes.Buffer
+		red   = color.RGBA{R: 0xff, A: 0xff}
+		blue  = color.RGBA{B: 0xff, A: 0xff}
+		green = color.RGBA{G: 0xff, A: 0xff}
+		pal   = color.Palette{red, blue, green}
+	)
+	frame := func(r image.Rectangle, c color.Color) *image.Paletted {
+		img := image.NewPaletted(r, pal)
+		draw.Draw(img, r, image.NewUniform(c), image.Point{}, draw.Src)
+		return img
+	}
+	g := &gif.GIF{
+		Image: []*image.Paletted{
+			frame(image.Rect(0, 0, 4, 4), red),
+			frame(image.Rect(2, 2, 4, 4), blue),
+			frame(image.Rect(0, 0, 1, 1), green),
+		},
+		Delay:    []int{5, 5, 5},
+		Disposal: []byte{gif.DisposalNone, gif.DisposalBackground, gif.DisposalNone},
+	}
+	must.NoError(t, gif.EncodeAll(&anim, g))
+
+	files := map[string][]byte{
+		"still.webp": still,
+		"anim.webp":  animatedWebP(t, still, 2),
+		"anim.gif":   anim.Bytes(),
+	}
+	c := fakeClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// content type is intentionally wrong to check magic bytes detection
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(files[r.URL.Query().Get("filename")])
+	}))
+	ctx := context.Background()
+
+	img, err := c.GetImage(ctx, ImageRef{Filename: "still.webp"})
+	must.NoError(t, err)
+	must.Positive(t, img.Bounds().Dx())
+
+	frames, err := c.GetImageFrames(ctx, ImageRef{Filename: "anim.webp"})
+	must.NoError(t, err)
+	must.Len(t, 2, frames)
+	must.EqOp(t, 200*time.Millisecond, frames[1].Delay)
+	must.Eq(t, img.Bounds(), frames[1].Image.Bounds())
+
+	frames, err = c.GetImageFrames(ctx, ImageRef{Filename: "anim.gif"})
+	must.NoError(t, err)
+	must.Len(t, 3, frames)
+	must.EqOp(t, 50*time.Millisecond, frames[0].Delay)
+	// frames are composited, so pixels outside the frame bounds are preserved
+	must.Eq[color.Color](t, color.NRGBA(red), frames[1].Image.At(0, 0))
+	must.Eq[color.Color](t, color.NRGBA(blue), frames[1].Image.At(3, 3))
+	must.Eq[color.Color](t, color.NRGBA(green), frames[2].Image.At(0, 0))
+	must.Eq[color.Color](t, color.NRGBA(red), frames[2].Image.At(1, 1))
+	// the second frame is disposed to the background
+	must.Eq[color.Color](t, color.NRGBA{}, frames[2].Image.At(3, 3))
+}
+
+func TestOutputFetch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var buf bytes.Buffer
+	must.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 2, 3))))
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /view", func(w http.ResponseWriter, r *http.Request) {
+		must.EqOp(t, "a.png", r.URL.Query().Get("filename"))
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(buf.Bytes())
+	})
+	mux.Handle("/", fakeComfy(t, 1))
+	c := fakeClient(t, mux, WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+	// fake server reports outputs for node 9
+	g := apigraph.New()
+	g.LastID = 7
+	_, img := apinodes.EmptyImage(g, 2, 3, 1, 0)
+	_, out := apinodes.SaveImage(g, img, "test")
+	must.EqOp(t, 9, out.NodeID)
+
+	res, err := c.RunPrompt(ctx, g)
+	must.NoError(t, err)
+	must.Eq(t, []ImageRef{{Filename: "a.png", Type: ImageOutput}}, out.From(res))
+
+	images, err := out.Fetch(ctx, c, res)
+	must.NoError(t, err)
+	must.SliceLen(t, 1, images)
+	must.Eq(t, image.Rect(0, 0, 2, 3), images[0].Bounds())
+
+	files, err := out.Output.Fetch(ctx, c, res)
+	must.NoError(t, err)
+	must.Eq(t, [][]byte{buf.Bytes()}, files)
+}
@@ -0,0 +1,288 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dennwc/gocomfy/graph/types"
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+type JobStatus string
+
+const (
+	JobPending    = JobStatus("pending")
+	JobInProgress = JobStatus("in_progress")
+	JobCompleted  = JobStatus("completed")
+	JobFailed     = JobStatus("failed")
+	JobCancelled  = JobStatus("cancelled")
+)
+
+// IsDone checks if the job reached a terminal state.
+func (s JobStatus) IsDone() bool {
+	switch s {
+	case JobCompleted, JobFailed, JobCancelled:
+		return true
+	}
+	return false
+}
+
+// ErrJobCancelled is returned by WaitJob if the job was cancelled.
+var ErrJobCancelled = errors.New("job cancelled")
+
+type JobSort string
+
+const (
+	SortByCreated  = JobSort("created_at")
+	SortByDuration = JobSort("execution_duration")
+)
+
+type ListJobsOpts struct {
+	Offset int
+	Limit  int
+
+	// Status only returns jobs with given statuses.
+	Status []JobStatus
+	// WorkflowID only returns jobs for a given workflow.
+	WorkflowID string
+	// Since only returns jobs created at or after a given time.
+	// Filtering is done on the client side, see ListJobsPage.
+	Since time.Time
+	// Until only returns jobs created before a given time.
+	// Filtering is done on the client side, see ListJobsPage.
+	Until time.Time
+	// Sort sets a field to sort jobs by.
+	Sort JobSort
+	// Asc sets ascending sort order. Jobs are sorted in descending order by default.
+	Asc bool
+}
+
+func (opts *ListJobsOpts) setURL(qu url.Values) {
+	qu.Set("offset", strconv.Itoa(opts.Offset))
+	qu.Set("limit", strconv.Itoa(opts.Limit))
+	if len(opts.Status) != 0 {
+		arr := make([]string, 0, len(opts.Status))
+		for _, s := range opts.Status {
+			arr = append(arr, string(s))
+		}
+		qu.Set("status", strings.Join(arr, ","))
+	}
+	if opts.WorkflowID != "" {
+		qu.Set("workflow_id", opts.WorkflowID)
+	}
+	if opts.Sort != "" {
+		qu.Set("sort_by", string(opts.Sort))
+	}
+	if opts.Asc {
+		qu.Set("sort_order", "asc")
+	} else {
+		qu.Set("sort_order", "desc")
+	}
+}
+
+// past checks if the job and all jobs on the following pages are outside of the Since/Until range.
+// It's only possible to tell when jobs are sorted by the creation time.
+func (opts *ListJobsOpts) past(j *Job) bool {
+	if opts.Sort != "" && opts.Sort != SortByCreated {
+		return false
+	}
+	if opts.Asc {
+		return !opts.Until.IsZero() && !j.Created.Time().Before(opts.Until)
+	}
+	return !opts.Since.IsZero() && j.Created.Time().Before(opts.Since)
+}
+
+func (opts *ListJobsOpts) match(j *Job) bool {
+	if !opts.Since.IsZero() && j.Created.Time().Before(opts.Since) {
+		return false
+	}
+	if !opts.Until.IsZero() && !j.Created.Time().Before(opts.Until) {
+		return false
+	}
+	return true
+}
+
+// UnixMilli is a timestamp in milliseconds, as used by ComfyUI.
+type UnixMilli int64
+
+func (t UnixMilli) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(t))
+}
+
+type Job struct {
+	ID            string     `json:"id"`
+	Status        JobStatus  `json:"status"`
+	Priority      int64      `json:"priority"`
+	WorkflowID    string     `json:"workflow_id"`
+	PreviewOutput *ImageRef  `json:"preview_output"`
+	OutputsCount  int        `json:"outputs_count"`
+	Created       UnixMilli  `json:"create_time"`
+	ExecStart     UnixMilli  `json:"execution_start_time"`
+	ExecEnd       UnixMilli  `json:"execution_end_time"`
+	Error         *JobError  `json:"execution_error"`
+	Outputs       JobOutputs `json:"outputs"`
+}
+
+// Duration returns execution time of the job. It returns zero if the job has not finished yet.
+func (j *Job) Duration() time.Duration {
+	if j.ExecStart == 0 || j.ExecEnd == 0 {
+		return 0
+	}
+	return j.ExecEnd.Time().Sub(j.ExecStart.Time())
+}
+
+type JobError struct {
+	Node          string          `json:"node_id"`
+	NodeType      string          `json:"node_type"`
+	Exception     string          `json:"exception_message"`
+	ExceptionType string          `json:"exception_type"`
+	Traceback     []string        `json:"traceback"`
+	CurrentInputs json.RawMessage `json:"current_inputs"`
+}
+
+func (e *JobError) Error() string {
+	if e.Node == "" {
+		return fmt.Sprintf("%s: %s", e.ExceptionType, e.Exception)
+	}
+	return fmt.Sprintf("node %s (%s): %s: %s", e.Node, e.NodeType, e.ExceptionType, e.Exception)
+}
+
+// JobOutputs are outputs of the job nodes. They are only returned by GetJob.
+type JobOutputs map[types.NodePath]wsconn.NodeOutput
+
+// Results converts job outputs to prompt results.
+func (o JobOutputs) Results() (Results, error) {
+	return o.PathResults().ByNode()
+}
+
+// PathResults converts job outputs to prompt results, keyed by full node paths.
+func (o JobOutputs) PathResults() PathResults {
+	out := make(PathResults, len(o))
+	for node, v := range o {
+		out[node] = nodeResult(v)
+	}
+	return out
+}
+
+// listJobsPage returns matching jobs from a single page and the number of jobs on the page.
+// It also reports if the following pages can't have matching jobs.
+func (c *Client) listJobsPage(ctx context.Context, opts *ListJobsOpts) (_ []Job, _ int, last bool, _ error) {
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+	qu := make(url.Values)
+	opts.setURL(qu)
+
+	var out struct {
+		Items []Job `json:"jobs"`
+	}
+	err := c.getJSON(ctx, "/api/jobs?"+qu.Encode(), &out)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	n := len(out.Items)
+	if n != 0 {
+		last = opts.past(&out.Items[n-1])
+	}
+	list := out.Items[:0]
+	for _, j := range out.Items {
+		if opts.match(&j) {
+			list = append(list, j)
+		}
+	}
+	return list, n, last, nil
+}
+
+// ListJobsPage returns a single page of jobs.
+//
+// Since and Until filters are applied to the page after it's fetched, thus the page may contain fewer jobs than the limit,
+// or even be empty, while the next pages may still have matching jobs. Use ListJobsSeq to iterate over all matching jobs.
+func (c *Client) ListJobsPage(ctx context.Context, opts *ListJobsOpts) ([]Job, error) {
+	if opts == nil {
+		opts = &ListJobsOpts{}
+	}
+	list, _, _, err := c.listJobsPage(ctx, opts)
+	return list, err
+}
+
+// ListJobsSeq iterates over all jobs matching the options, fetching them page by page.
+//
+// When jobs are sorted by the creation time, it stops as soon as the page reaches the end of the Since/Until range.
+func (c *Client) ListJobsSeq(ctx context.Context, opts *ListJobsOpts) iter.Seq2[Job, error] {
+	return func(yield func(Job, error) bool) {
+		if opts == nil {
+			opts = &ListJobsOpts{}
+		}
+		for {
+			list, n, last, err := c.listJobsPage(ctx, opts)
+			if err != nil {
+				yield(Job{}, err)
+				return
+			}
+			if n == 0 {
+				return
+			}
+			opts.Offset += n
+			for _, a := range list {
+				if !yield(a, nil) {
+					return
+				}
+			}
+			if last {
+				return
+			}
+		}
+	}
+}
+
+// GetJob returns job details, including outputs, timings and errors.
+func (c *Client) GetJob(ctx context.Context, id string) (*Job, error) {
+	var out Job
+	if err := c.getJSON(ctx, "/api/jobs/"+url.PathEscape(id), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WaitJob polls the job status with a given interval until it reaches a terminal state.
+// If the interval is zero, a default of one second is used.
+//
+// It works without the websocket connection. If the job failed, it returns the job and JobError.
+// If the job was cancelled, it returns the job and ErrJobCancelled.
+func (c *Client) WaitJob(ctx context.Context, id string, interval time.Duration) (*Job, error) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		j, err := c.GetJob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if j.Status.IsDone() {
+			if j.Status == JobCancelled {
+				return j, ErrJobCancelled
+			}
+			if j.Error != nil {
+				return j, j.Error
+			}
+			return j, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package gocomfy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestWaitJob(t *testing.T) {
+	calls := 0
+	c := fakeClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		must.EqOp(t, "/api/jobs/p1", r.URL.Path)
+		calls++
+		status := JobInProgress
+		if calls >= 3 {
+			status = JobCompleted
+		}
+		fmt.Fprintf(w, `{"id":"p1","status":%q,"create_time":1000,"execution_start_time":2000,"execution_end_time":3500,
+			"outputs":{"9":{"images":[{"filename":"a.png","subfolder":"","type":"output"}]}}}`, status)
+	}))
+	j, err := c.WaitJob(context.Background(), "p1", time.Millisecond)
+	must.NoError(t, err)
+	must.EqOp(t, 3, calls)
+	must.EqOp(t, JobCompleted, j.Status)
+	must.EqOp(t, 1500*time.Millisecond, j.Duration())
+	res, err := j.Outputs.Results()
+	must.NoError(t, err)
+	must.EqOp(t, "a.png", res[9].Images[0].Filename)
+}
+
+func TestWaitJobCancelled(t *testing.T) {
+	c := fakeClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"p1","status":"cancelled","create_time":1000}`)
+	}))
+	j, err := c.WaitJob(context.Background(), "p1", time.Millisecond)
+	must.ErrorIs(t, err, ErrJobCancelled)
+	must.EqOp(t, JobCancelled, j.Status)
+}
+
+func TestListJobsSeq(t *testing.T) {
+	// 10 jobs created at 1..10 seconds, sorted by creation time in descending order
+	var pages []int
+	c := fakeClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		must.EqOp(t, "/api/jobs", r.URL.Path)
+		offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+		must.NoError(t, err)
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		must.NoError(t, err)
+		pages = append(pages, offset)
+		var items []string
+		for i := 10 - offset; i > 10-offset-limit && i > 0; i-- {
+			items = append(items, fmt.Sprintf(`{"id":"p%d","status":"completed","create_time":%d}`, i, i*1000))
+		}
+		fmt.Fprintf(w, `{"jobs":[%s]}`, strings.Join(items, ","))
+	}))
+	opts := &ListJobsOpts{
+		Limit: 2,
+		Since: time.UnixMilli(5000),
+		Until: time.UnixMilli(8000),
+	}
+	var ids []string
+	for j, err := range c.ListJobsSeq(context.Background(), opts) {
+		must.NoError(t, err)
+		ids = append(ids, j.ID)
+	}
+	must.Eq(t, []string{"p7", "p6", "p5"}, ids)
+	// stops after the page with jobs older than Since
+	must.Eq(t, []int{0, 2, 4, 6}, pages)
+}
@@ -0,0 +1,128 @@
+package gocomfy
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+type LogEntry = wsconn.LogEntry
+
+// Logs returns recent log entries of the server.
+func (c *Client) Logs(ctx context.Context) ([]LogEntry, error) {
+	var out struct {
+		Entries []LogEntry `json:"entries"`
+	}
+	if err := c.getJSON(ctx, "/internal/logs/raw", &out); err != nil {
+		return nil, err
+	}
+	return out.Entries, nil
+}
+
+func (c *Client) subscribeLogs(ctx context.Context, enabled bool) error {
+	return c.sendJSON(ctx, "PATCH", "/internal/logs/subscribe", struct {
+		ClientID string `json:"clientId"`
+		Enabled  bool   `json:"enabled"`
+	}{
+		ClientID: c.id,
+		Enabled:  enabled,
+	}, nil)
+}
+
+// syncLogSubs subscribes to the server logs if there are any streams, and unsubscribes otherwise.
+//
+// Requests are serialized and always reflect the latest set of streams,
+// so concurrent subscribe and unsubscribe calls cannot leave the server in a stale state.
+func (c *Client) syncLogSubs(ctx context.Context) error {
+	c.logSubMu.Lock()
+	defer c.logSubMu.Unlock()
+	c.mu.RLock()
+	enabled := len(c.logSubs) != 0 && c.prompts != nil
+	c.mu.RUnlock()
+	if enabled == c.logSubbed {
+		return nil
+	}
+	if err := c.subscribeLogs(ctx, enabled); err != nil {
+		return err
+	}
+	c.logSubbed = enabled
+	return nil
+}
+
+// StreamLogs subscribes to server logs and returns a channel of new log entries.
+// The channel is closed when the context is canceled or the client is closed.
+//
+// It requires a websocket connection. If the consumer cannot keep up with the logs, entries are dropped.
+func (c *Client) StreamLogs(ctx context.Context) (<-chan LogEntry, error) {
+	if c.conn == nil {
+		return nil, errors.New("websocket is not initialized")
+	}
+	ch := make(chan LogEntry, 100)
+	c.mu.Lock()
+	if c.prompts == nil {
+		c.mu.Unlock()
+		return nil, errors.New("connection closed")
+	}
+	if c.logSubs == nil {
+		c.logSubs = make(map[chan LogEntry]chan struct{})
+	}
+	stop := make(chan struct{})
+	c.logSubs[ch] = stop
+	c.mu.Unlock()
+	if err := c.syncLogSubs(ctx); err != nil {
+		c.delLogSub(ch)
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stop:
+			return
+		}
+		c.delLogSub(ch)
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		_ = c.syncLogSubs(ctx)
+	}()
+	return ch, nil
+}
+
+// delLogSub removes and closes the log subscription.
+func (c *Client) delLogSub(ch chan LogEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stop, ok := c.logSubs[ch]
+	if !ok {
+		return
+	}
+	delete(c.logSubs, ch)
+	close(stop)
+	close(ch)
+}
+
+func (c *Client) closeLogSubs() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for ch, stop := range c.logSubs {
+		close(stop)
+		close(ch)
+	}
+	c.logSubs = nil
+}
+
+func (c *Client) procLogs(log *slog.Logger, ev *wsconn.LogsEvent) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for ch := range c.logSubs {
+		for _, e := range ev.Entries {
+			select {
+			case ch <- e:
+			default:
+				log.Debug("dropping log entry")
+			}
+		}
+	}
+}
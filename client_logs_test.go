@@ -0,0 +1,113 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+func TestLogs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	subs := make(chan bool, 10)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /internal/logs/raw", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"entries":[
+			{"t":"2024-01-02T03:04:05.123456","m":"Starting server\n"},
+			{"t":"2024-01-02T03:04:06+00:00","m":"To see the GUI go to: http://127.0.0.1:8188\n"}
+		],"size":{"cols":80,"rows":24}}`))
+	})
+	mux.HandleFunc("PATCH /internal/logs/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ClientID string `json:"clientId"`
+			Enabled  bool   `json:"enabled"`
+		}
+		must.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		must.NotEq(t, "", req.ClientID)
+		subs <- req.Enabled
+	})
+	events := make(chan wsconn.Event)
+	defer close(events)
+	c := fakeWSClient(t, mux, events)
+
+	logs, err := c.Logs(ctx)
+	must.NoError(t, err)
+	must.Len(t, 2, logs)
+	must.EqOp(t, "Starting server\n", logs[0].Message)
+	must.True(t, logs[0].Time.Equal(time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.Local)))
+	must.True(t, logs[1].Time.Equal(time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC)))
+
+	sctx, scancel := context.WithCancel(ctx)
+	ch, err := c.StreamLogs(sctx)
+	must.NoError(t, err)
+	must.True(t, <-subs)
+
+	events <- &wsconn.LogsEvent{Entries: []LogEntry{{Message: "a"}, {Message: "b"}}}
+	must.EqOp(t, "a", (<-ch).Message)
+	must.EqOp(t, "b", (<-ch).Message)
+
+	// unsubscribes when the last stream is cancelled
+	scancel()
+	_, ok := <-ch
+	must.False(t, ok)
+	must.False(t, <-subs)
+
+	// logs stream requires a websocket
+	c = fakeClient(t, mux)
+	_, err = c.StreamLogs(ctx)
+	must.Error(t, err)
+}
+
+func TestStreamLogsResubscribe(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var (
+		mu      sync.Mutex
+		enabled bool
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("PATCH /internal/logs/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Enabled bool `json:"enabled"`
+		}
+		must.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if !req.Enabled {
+			// let the next subscribe request overtake this one, if it's not serialized
+			time.Sleep(5 * time.Millisecond)
+		}
+		mu.Lock()
+		enabled = req.Enabled
+		mu.Unlock()
+	})
+	events := make(chan wsconn.Event)
+	defer close(events)
+	c := fakeWSClient(t, mux, events)
+
+	// unsubscribe of the previous stream must not win over subscribe of the next one
+	var scancel context.CancelFunc
+	for range 20 {
+		var sctx context.Context
+		prev := scancel
+		sctx, scancel = context.WithCancel(ctx)
+		if prev != nil {
+			prev()
+			time.Sleep(time.Millisecond)
+		}
+		_, err := c.StreamLogs(sctx)
+		must.NoError(t, err)
+	}
+	defer scancel()
+	time.Sleep(20 * time.Millisecond)
+	c.logSubMu.Lock()
+	defer c.logSubMu.Unlock()
+	mu.Lock()
+	defer mu.Unlock()
+	must.True(t, enabled)
+}
@@ -0,0 +1,184 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+const (
+	defaultPollInterval    = 500 * time.Millisecond
+	defaultPollMaxInterval = 5 * time.Second
+)
+
+// WithPollInterval sets the interval for polling prompt status when the websocket is disabled.
+//
+// When the prompt state does not change, the interval is doubled on each poll, up to the max value.
+// Setting max to the same value as the interval disables the backoff.
+func WithPollInterval(interval, max time.Duration) ClientOption {
+	return clientOptionFunc(func(c *clientOptions) {
+		c.PollInterval = interval
+		c.PollMaxInterval = max
+	})
+}
+
+type historyEntry struct {
+	Outputs map[wsconn.NodeID]wsconn.NodeOutput `json:"outputs"`
+	Status  struct {
+		Status    string              `json:"status_str"`
+		Completed bool                `json:"completed"`
+		Messages  []wsconn.RawMessage `json:"messages"`
+	} `json:"status"`
+}
+
+// promptHistory returns the history entry for a prompt. It returns nil if the prompt is not complete yet.
+func (c *Client) promptHistory(ctx context.Context, pid string) (*historyEntry, error) {
+	var res map[string]*historyEntry
+	err := c.getJSON(ctx, "/history/"+pid, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res[pid], nil
+}
+
+// promptQueueState checks if the prompt is currently executed by the server or is pending in the queue.
+func (c *Client) promptQueueState(ctx context.Context, pid string) (running, pending bool, _ error) {
+	var res struct {
+		Running [][]json.RawMessage `json:"queue_running"`
+		Pending [][]json.RawMessage `json:"queue_pending"`
+	}
+	err := c.getJSON(ctx, "/queue", &res)
+	if err != nil {
+		return false, false, err
+	}
+	return queueHasPrompt(res.Running, pid), queueHasPrompt(res.Pending, pid), nil
+}
+
+func queueHasPrompt(items [][]json.RawMessage, pid string) bool {
+	for _, item := range items {
+		if len(item) < 2 {
+			continue
+		}
+		var id string
+		if err := json.Unmarshal(item[1], &id); err == nil && id == pid {
+			return true
+		}
+	}
+	return false
+}
+
+// poll emulates websocket events for the prompt by polling the queue and the history.
+func (p *Prompt) poll() {
+	var (
+		ctx      = p.ctx
+		interval = p.c.pollInterval
+		started  bool
+	)
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			p.Close()
+			return
+		case <-timer.C:
+		}
+		if p.closed.Load() {
+			return
+		}
+		changed, done, err := p.pollOnce(ctx, &started)
+		if err != nil {
+			p.log.Error("cannot poll prompt status", "err", err)
+		}
+		if done {
+			p.close()
+			return
+		}
+		if changed {
+			interval = p.c.pollInterval
+		} else {
+			interval = min(2*interval, p.c.pollMaxInterval)
+		}
+		timer.Reset(interval)
+	}
+}
+
+func (p *Prompt) pollOnce(ctx context.Context, started *bool) (changed, done bool, _ error) {
+	h, err := p.c.promptHistory(ctx, p.pid)
+	if err != nil {
+		return false, false, err
+	}
+	if h == nil {
+		running, pending, err := p.c.promptQueueState(ctx, p.pid)
+		if err != nil {
+			return false, false, err
+		}
+		if running && !*started {
+			*started = true
+			return true, false, p.processEvent(&wsconn.ExecStart{PromptEventBase: p.eventBase()})
+		} else if running || pending {
+			return false, false, nil
+		}
+		// The prompt might have completed after the history was requested, so check it again before giving up.
+		if h, err = p.c.promptHistory(ctx, p.pid); err != nil {
+			return false, false, err
+		} else if h == nil {
+			return true, true, p.processEvent(&wsconn.ExecError{PromptEventBase: p.eventBase(), Exception: "prompt was removed from the queue"})
+		}
+	}
+	// Prompt is complete. Replay messages recorded by the server and emit results for each output node.
+	var failure wsconn.PromptEvent
+	for _, m := range h.Status.Messages {
+		ev, err := m.Decode()
+		if err != nil {
+			return true, true, err
+		}
+		switch ev.(type) {
+		case *wsconn.ExecStart:
+			if *started {
+				continue
+			}
+			*started = true
+		case *wsconn.ExecCached:
+		case *wsconn.ExecError, *wsconn.ExecInterrupted:
+			failure = ev.(wsconn.PromptEvent)
+			continue
+		default:
+			continue
+		}
+		if err = p.processEvent(ev.(wsconn.PromptEvent)); err != nil {
+			return true, true, err
+		}
+	}
+	if !*started {
+		*started = true
+		if err = p.processEvent(&wsconn.ExecStart{PromptEventBase: p.eventBase()}); err != nil {
+			return true, true, err
+		}
+	}
+	nodes := slices.Sorted(maps.Keys(h.Outputs))
+	for _, node := range nodes {
+		if err = p.processEvent(&wsconn.ExecNode{PromptEventBase: p.eventBase(), Node: &node}); err != nil {
+			return true, true, err
+		}
+		if err = p.processEvent(&wsconn.ExecNodeDone{PromptEventBase: p.eventBase(), Node: node, Output: h.Outputs[node]}); err != nil {
+			return true, true, err
+		}
+	}
+	if failure == nil && h.Status.Status == "error" {
+		failure = &wsconn.ExecError{PromptEventBase: p.eventBase(), Exception: "prompt execution failed"}
+	}
+	if failure != nil {
+		return true, true, p.processEvent(failure)
+	}
+	err = p.processEvent(&wsconn.ExecNode{PromptEventBase: p.eventBase()})
+	return true, true, err
+}
+
+func (p *Prompt) eventBase() wsconn.PromptEventBase {
+	return wsconn.PromptEventBase{PromptID: p.pid}
+}
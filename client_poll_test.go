@@ -0,0 +1,174 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+)
+
+// fakeComfy emulates prompt execution on the server, completing the prompt after a given number of history polls.
+func fakeComfy(t testing.TB, polls int32) http.Handler {
+	var (
+		pid   = "p1"
+		calls atomic.Int32
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /prompt", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Prompt json.RawMessage `json:"prompt"`
+		}
+		must.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		fmt.Fprintf(w, `{"prompt_id":%q}`, pid)
+	})
+	mux.HandleFunc("GET /queue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"queue_running":[[0,%q,{},{},[]]],"queue_pending":[]}`, pid)
+	})
+	mux.HandleFunc("GET /history/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= polls {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		fmt.Fprintf(w, `{%q:{
+			"outputs":{
+				"9":{"images":[{"filename":"a.png","subfolder":"","type":"output"}]},
+				"12:5":{"images":[{"filename":"b.png","subfolder":"","type":"output"}]}
+			},
+			"status":{"status_str":"success","completed":true,"messages":[
+				["execution_start",{"prompt_id":%[1]q,"timestamp":1}],
+				["execution_cached",{"prompt_id":%[1]q,"timestamp":1,"nodes":["4","12","12:3"]}],
+				["execution_success",{"prompt_id":%[1]q,"timestamp":2}]
+			]}
+		}}`, pid)
+	})
+	return mux
+}
+
+func TestPromptPolling(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c := fakeClient(t, fakeComfy(t, 3), WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+	p, err := c.PromptJSON(ctx, json.RawMessage(`{
+		"4":{"class_type":"A"}, "9":{"class_type":"B"}, "12:3":{"class_type":"C"}, "12:5":{"class_type":"D"}
+	}`))
+	must.NoError(t, err)
+	var events []Event
+	var last Progress
+	for ev := range p.Events() {
+		if ev, ok := ev.(ExecProg); ok {
+			must.GreaterEq(t, last.Fraction, ev.Fraction)
+			last = ev.Progress
+			continue
+		}
+		events = append(events, ev)
+	}
+	must.EqOp(t, 1.0, last.Fraction)
+	must.EqOp(t, NodeCached, p.Progress().Nodes["12:3"].State)
+	must.Eq(t, []Event{
+		ExecStart{},
+		ExecCache{Nodes: []NodeID{4, 12}, Paths: []NodePath{"4", "12", "12:3"}},
+		NodeStart{Node: 12, Path: "12:5"},
+		NodeDone{Node: 12, Path: "12:5", NodeResult: NodeResult{Images: []ImageRef{{Filename: "b.png", Type: ImageOutput}}}},
+		NodeStart{Node: 9, Path: "9"},
+		NodeDone{Node: 9, Path: "9", NodeResult: NodeResult{Images: []ImageRef{{Filename: "a.png", Type: ImageOutput}}}},
+		ExecDone{},
+	}, events)
+
+	res, err := p.Results(ctx)
+	must.NoError(t, err)
+	must.EqOp(t, "a.png", res[9].Images[0].Filename)
+	must.EqOp(t, "b.png", res[12].Images[0].Filename)
+
+	pres, err := p.PathResults(ctx)
+	must.NoError(t, err)
+	must.EqOp(t, "b.png", pres["12:5"].Images[0].Filename)
+}
+
+func TestPromptPollingError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, c := range []struct {
+		name     string
+		messages string
+		err      string
+	}{
+		{
+			name: "error",
+			messages: `["execution_error",{"prompt_id":"p1","timestamp":2,"node_id":"12:5","node_type":"D",
+				"exception_type":"RuntimeError","exception_message":"boom","traceback":[],"executed":["9"]}]`,
+			err: "node 12:5 (D): RuntimeError: boom",
+		},
+		{
+			name:     "interrupted",
+			messages: `["execution_interrupted",{"prompt_id":"p1","timestamp":2,"node_id":"12:5","node_type":"D","executed":["9"]}]`,
+			err:      "node 12:5 (D): prompt execution interrupted",
+		},
+		{
+			name: "status",
+			err:  "prompt execution failed",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /history/{id}", func(w http.ResponseWriter, r *http.Request) {
+				msgs := `["execution_start",{"prompt_id":"p1","timestamp":1}]`
+				if c.messages != "" {
+					msgs += "," + c.messages
+				}
+				fmt.Fprintf(w, `{"p1":{
+					"outputs":{"9":{"images":[{"filename":"a.png","subfolder":"","type":"output"}]}},
+					"status":{"status_str":"error","completed":false,"messages":[%s]}
+				}}`, msgs)
+			})
+			mux.Handle("/", fakeComfy(t, 0))
+			cli := fakeClient(t, mux, WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+			p, err := cli.PromptJSON(ctx, json.RawMessage(`{"9":{"class_type":"B"},"12:5":{"class_type":"D"}}`))
+			must.NoError(t, err)
+			var last Event
+			for ev := range p.Events() {
+				if _, ok := ev.(ExecProg); !ok {
+					last = ev
+				}
+			}
+			_, ok := last.(ExecError)
+			must.True(t, ok)
+			must.EqError(t, p.Wait(ctx), c.err)
+
+			_, err = cli.RunPromptJSON(ctx, json.RawMessage(`{"9":{"class_type":"B"}}`))
+			must.EqError(t, err, c.err)
+		})
+	}
+}
+
+func TestPromptPollingRemoved(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var queued atomic.Int32
+	queued.Store(3)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /history/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("GET /queue", func(w http.ResponseWriter, r *http.Request) {
+		// pending for a few polls, then removed from the queue without a history entry
+		if queued.Add(-1) >= 0 {
+			_, _ = w.Write([]byte(`{"queue_running":[],"queue_pending":[[0,"p1",{},{},[]]]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"queue_running":[],"queue_pending":[]}`))
+	})
+	mux.Handle("/", fakeComfy(t, 0))
+	c := fakeClient(t, mux, WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+	p, err := c.PromptJSON(ctx, json.RawMessage(`{"9":{"class_type":"B"}}`), WithoutEvents())
+	must.NoError(t, err)
+	must.EqError(t, p.Wait(ctx), "prompt was removed from the queue")
+	must.Negative(t, queued.Load())
+}
@@ -4,10 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"iter"
+	"fmt"
 	"log/slog"
-	"net/url"
-	"strconv"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -16,63 +15,6 @@ import (
 	"github.com/dennwc/gocomfy/wsconn"
 )
 
-type ListJobsOpts struct {
-	Offset int
-	Limit  int
-}
-
-type Job struct {
-	ID            string    `json:"id"`
-	Status        string    `json:"status"`
-	WorkflowID    string    `json:"workflow_id"`
-	PreviewOutput *ImageRef `json:"preview_output"`
-}
-
-func (c *Client) ListJobsPage(ctx context.Context, opts *ListJobsOpts) ([]Job, error) {
-	if opts == nil {
-		opts = &ListJobsOpts{}
-	}
-	if opts.Limit <= 0 {
-		opts.Limit = 20
-	}
-	qu := make(url.Values)
-	qu.Set("offset", strconv.Itoa(opts.Offset))
-	qu.Set("limit", strconv.Itoa(opts.Limit))
-
-	var out struct {
-		Items []Job `json:"jobs"`
-	}
-	err := c.getJSON(ctx, "/api/jobs?"+qu.Encode(), &out)
-	if err != nil {
-		return nil, err
-	}
-	return out.Items, nil
-}
-
-func (c *Client) ListJobsSeq(ctx context.Context, opts *ListJobsOpts) iter.Seq2[Job, error] {
-	return func(yield func(Job, error) bool) {
-		if opts == nil {
-			opts = &ListJobsOpts{}
-		}
-		for {
-			list, err := c.ListJobsPage(ctx, opts)
-			if err != nil {
-				yield(Job{}, err)
-				return
-			}
-			if len(list) == 0 {
-				return
-			}
-			opts.Offset += len(list)
-			for _, a := range list {
-				if !yield(a, nil) {
-					return
-				}
-			}
-		}
-	}
-}
-
 func (c *Client) cancelAllPrompts(ctx context.Context) error {
 	return c.postJSON(ctx, "/queue", struct {
 		Clear bool `json:"clear"`
@@ -96,10 +38,6 @@ func (c *Client) CancelPrompts(ctx context.Context, pids ...string) error {
 	}, nil)
 }
 
-type PromptOption interface {
-	todo()
-}
-
 func (c *Client) startPrompt(ctx context.Context, prompt any) (string, error) {
 	var res struct {
 		PromptID string `json:"prompt_id"`
@@ -125,32 +63,42 @@ func (c *Client) StartPrompt(ctx context.Context, g *apigraph.Graph) (string, er
 	return c.startPrompt(ctx, g)
 }
 
-type NodeResult struct {
-	Images []ImageRef
+func nodeResult(o wsconn.NodeOutput) NodeResult {
+	return NodeResult{
+		Images:   o.Images,
+		Audio:    o.Audio,
+		Text:     o.Text,
+		Animated: slices.Contains(o.Animated, true),
+	}
 }
 
-type Results map[types.NodeID]NodeResult
-
-func (c *Client) PromptResults(ctx context.Context, pid string) (Results, error) {
+func (c *Client) PromptPathResults(ctx context.Context, pid string) (PathResults, error) {
 	var res map[string]struct {
-		Outputs map[types.NodeID]struct {
-			Images []ImageRef `json:"images"`
-		}
+		Outputs map[types.NodePath]wsconn.NodeOutput
 	}
 	err := c.getJSON(ctx, "/history/"+pid, &res)
 	if err != nil {
 		return nil, err
 	}
-	out := make(Results)
+	out := make(PathResults)
 	for node, v := range res[pid].Outputs {
-		out[node] = NodeResult{Images: v.Images}
+		out[node] = nodeResult(v)
 	}
 	return out, nil
 }
 
+func (c *Client) PromptResults(ctx context.Context, pid string) (Results, error) {
+	res, err := c.PromptPathResults(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	return res.ByNode()
+}
+
 func (c *Client) promptRaw(ctx context.Context, prompt any, opts ...PromptOption) (*Prompt, error) {
-	if c.conn == nil {
-		return nil, errors.New("websocket is not initialized")
+	var opt promptOptions
+	for _, o := range opts {
+		o.applyToPrompt(&opt)
 	}
 	c.mu.RLock()
 	closed := c.prompts == nil
@@ -158,17 +106,23 @@ func (c *Client) promptRaw(ctx context.Context, prompt any, opts ...PromptOption
 	if closed {
 		return nil, errors.New("connection closed")
 	}
+	submitted := time.Now()
 	pid, err := c.startPrompt(ctx, prompt)
 	if err != nil {
 		return nil, err
 	}
 	p := &Prompt{
-		c:      c,
-		log:    c.log.With("promptID", pid),
-		pid:    pid,
-		ctx:    ctx,
-		events: make(chan Event, 10),
-	}
+		c:        c,
+		log:      c.log.With("promptID", pid),
+		pid:      pid,
+		ctx:      ctx,
+		noEvents: opt.NoEvents,
+		events:   newEventQueue(),
+		done:     make(chan struct{}),
+	}
+	p.timing.submitted = submitted
+	p.timing.polling = c.conn == nil
+	p.prog.seed(prompt)
 	c.mu.Lock()
 	if c.prompts == nil {
 		c.mu.Unlock()
@@ -176,6 +130,10 @@ func (c *Client) promptRaw(ctx context.Context, prompt any, opts ...PromptOption
 	}
 	c.prompts[p.pid] = p
 	c.mu.Unlock()
+	go p.watch()
+	if c.conn == nil {
+		go p.poll()
+	}
 	return p, nil
 }
 
@@ -188,16 +146,42 @@ func (c *Client) Prompt(ctx context.Context, prompt *apigraph.Graph, opts ...Pro
 }
 
 func (c *Client) runPrompt(ctx context.Context, prompt any) (Results, error) {
-	p, err := c.promptRaw(ctx, prompt)
+	if c.cache == nil {
+		return c.runPromptUncached(ctx, prompt)
+	}
+	g, key, err := cacheKey(prompt)
+	if err != nil {
+		c.log.Warn("cannot compute prompt hash", "err", err)
+		return c.runPromptUncached(ctx, prompt)
+	}
+	res, ok, err := c.cachedResults(ctx, g, key)
+	if err != nil {
+		c.log.Warn("cannot read cached results", "err", err)
+	} else if ok {
+		return res, nil
+	}
+	res, err = c.runPromptUncached(ctx, prompt)
 	if err != nil {
 		return nil, err
 	}
-	defer p.Close()
-
-	// TODO: disable events for this one?
-	for range p.Events() {
+	if err = c.cacheResults(ctx, g, key, res); err != nil {
+		c.log.Warn("cannot cache results", "err", err)
 	}
+	return res, nil
+}
 
+func (c *Client) runPromptUncached(ctx context.Context, prompt any) (Results, error) {
+	p, err := c.promptRaw(ctx, prompt, WithoutEvents())
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close()
+	if err = p.Wait(ctx); err != nil {
+		return nil, err
+	}
+	if !p.completed() {
+		return nil, errors.New("connection lost before the prompt completed")
+	}
 	return p.Results(ctx)
 }
 
@@ -212,7 +196,8 @@ func (c *Client) RunPrompt(ctx context.Context, prompt *apigraph.Graph) (Results
 func (c *Client) procPromptEvent(log *slog.Logger, ev wsconn.PromptEvent) {
 	p := c.getPrompt(ev.GetPromptID())
 	if p == nil {
-		log.Error("cannot find prompt")
+		// Expected for events that follow an execution error, and for broadcast events of other clients.
+		log.Debug("cannot find prompt")
 		return
 	}
 	if err := p.processEvent(ev); err != nil {
@@ -220,29 +205,89 @@ func (c *Client) procPromptEvent(log *slog.Logger, ev wsconn.PromptEvent) {
 	}
 }
 
+// Prompt is a prompt queued on the server.
+//
+// Prompt events are buffered in an unbounded per-prompt queue, so a slow consumer never blocks
+// other prompts on the same Client. Events are delivered in the order they were received from the server.
+// If the consumer falls behind, pending NodeProg events for the same node and pending ExecProg events
+// are coalesced, and only the latest one is delivered. Other events are never dropped,
+// unless the prompt is closed or its context is cancelled.
 type Prompt struct {
-	c       *Client
-	log     *slog.Logger
-	pid     string
-	ctx     context.Context
-	events  chan Event
-	curNode NodeID
-	closed  atomic.Bool
+	c        *Client
+	log      *slog.Logger
+	pid      string
+	ctx      context.Context
+	noEvents bool
+	events   *eventQueue
+	done     chan struct{}
+	curNode  NodeID
+	curPath  NodePath
+	closed   atomic.Bool
+	complete atomic.Bool
+	failed   atomic.Pointer[ExecError]
+	prog     progressTracker
+	timing   timingRecorder
 }
 
 func (p *Prompt) ID() string {
 	return p.pid
 }
 
+// Events returns a channel of prompt events.
+//
+// The channel is closed after the last event is delivered, or when the prompt is closed.
+// If WithoutEvents option is set, the channel is closed without sending any events.
+//
+// Events are delivered by a background goroutine that is started by the first call to Events.
+// Callers must either read the channel until it's closed, or call Close.
 func (p *Prompt) Events() <-chan Event {
-	return p.events
+	return p.events.start(p.ctx)
+}
+
+// Done returns a channel that is closed when the prompt execution completes or the connection is lost.
+//
+// Some events may still be pending delivery on the Events channel when Done is closed.
+func (p *Prompt) Done() <-chan struct{} {
+	return p.done
+}
+
+// Wait waits for the prompt execution to complete.
+//
+// If the execution fails or is interrupted on the server, it returns ExecError.
+func (p *Prompt) Wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.ctx.Done():
+		return p.ctx.Err()
+	case <-p.done:
+		if e := p.failed.Load(); e != nil {
+			return *e
+		}
+		return nil
+	}
+}
+
+// completed reports whether the server finished executing the prompt successfully.
+func (p *Prompt) completed() bool {
+	return p.complete.Load() && p.failed.Load() == nil
+}
+
+// watch closes the prompt when its context is cancelled.
+func (p *Prompt) watch() {
+	select {
+	case <-p.done:
+	case <-p.ctx.Done():
+		p.Close()
+	}
 }
 
 func (p *Prompt) kill() bool {
 	if !p.closed.CompareAndSwap(false, true) {
 		return false // already closed
 	}
-	close(p.events)
+	p.events.close()
+	close(p.done)
 	return true
 }
 
@@ -258,7 +303,9 @@ func (p *Prompt) Cancel(ctx context.Context) error {
 	return p.c.CancelPrompts(ctx, p.pid)
 }
 
+// Close stops event delivery and cancels the prompt, if it's still running.
 func (p *Prompt) Close() {
+	p.events.abort()
 	if !p.close() {
 		return // already closed
 	}
@@ -273,6 +320,10 @@ func (p *Prompt) Results(ctx context.Context) (Results, error) {
 	return p.c.PromptResults(ctx, p.pid)
 }
 
+func (p *Prompt) PathResults(ctx context.Context) (PathResults, error) {
+	return p.c.PromptPathResults(ctx, p.pid)
+}
+
 func (p *Prompt) processEvent(ev wsconn.PromptEvent) error {
 	if p.closed.Load() {
 		return nil
@@ -288,48 +339,101 @@ func (p *Prompt) processEvent(ev wsconn.PromptEvent) error {
 		return p.procProgress(ev)
 	case *wsconn.ExecNodeDone:
 		return p.procExecuted(ev)
+	case *wsconn.ProgressState:
+		return p.procProgressState(ev)
+	case *wsconn.ExecError:
+		return p.procExecError(ev)
+	case *wsconn.ExecInterrupted:
+		return p.procExecInterrupted(ev)
 	default:
 		p.log.Debug("unknown event")
 		return nil
 	}
 }
 
+// event queues the event for delivery. It never blocks.
 func (p *Prompt) event(e Event) error {
-	if p.closed.Load() {
-		return nil
+	if err := p.ctx.Err(); err != nil {
+		return err
 	}
-	select {
-	case <-p.ctx.Done():
-		p.Close()
-		return p.ctx.Err()
-	case p.events <- e:
+	if p.closed.Load() || p.noEvents {
 		return nil
 	}
+	p.events.push(e)
+	return nil
 }
 
 func (p *Prompt) procExecStart(ev *wsconn.ExecStart) error {
+	now := time.Now()
+	p.prog.mu.Lock()
+	p.prog.start = now
+	p.prog.mu.Unlock()
+	p.timing.execStart(now)
 	return p.event(ExecStart{})
 }
 
+// nodePath converts node IDs from the websocket event. If display node is set, it is used as the top-level node.
+func nodePath(node wsconn.NodeID, display *wsconn.NodeID) (NodeID, NodePath, error) {
+	path := NodePath(node)
+	if display != nil && *display != "" {
+		path := NodePath(*display)
+		id, err := path.Root()
+		return id, NodePath(node), err
+	}
+	id, err := path.Root()
+	return id, path, err
+}
+
 func (p *Prompt) procExecCached(ev *wsconn.ExecCached) error {
 	var e ExecCache
+	seen := make(map[NodeID]struct{})
 	for _, node := range ev.Nodes {
-		var id NodeID
-		if err := id.Parse(string(node)); err != nil {
+		id, path, err := nodePath(node, nil)
+		if err != nil {
 			return err
 		}
-		e.Nodes = append(e.Nodes, id)
+		e.Paths = append(e.Paths, path)
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			e.Nodes = append(e.Nodes, id)
+		}
+	}
+	now := time.Now()
+	for _, path := range e.Paths {
+		id, _ := path.Root() // already validated
+		p.timing.nodeCached(id, path, now)
+	}
+	if err := p.event(e); err != nil {
+		return err
 	}
-	return p.event(e)
+	return p.progressed(func(t *progressTracker) {
+		for _, path := range e.Paths {
+			id, _ := path.Root() // already validated
+			t.node(id, path).State = NodeCached
+		}
+	})
 }
 
 func (p *Prompt) curDone() error {
-	if p.curNode == 0 {
+	if p.curPath == "" {
 		return nil
 	}
-	err := p.event(NodeDone{Node: p.curNode})
-	p.curNode = 0
-	return err
+	id, path := p.curNode, p.curPath
+	p.curNode, p.curPath = 0, ""
+	p.timing.nodeDone(id, path, time.Now())
+	if err := p.event(NodeDone{Node: id, Path: path}); err != nil {
+		return err
+	}
+	return p.nodeFinished(id, path)
+}
+
+func (p *Prompt) nodeFinished(id NodeID, path NodePath) error {
+	return p.progressed(func(t *progressTracker) {
+		n := t.node(id, path)
+		if n.State != NodeCached {
+			n.State = NodeFinished
+		}
+	})
 }
 
 func (p *Prompt) procExecuting(ev *wsconn.ExecNode) error {
@@ -337,44 +441,98 @@ func (p *Prompt) procExecuting(ev *wsconn.ExecNode) error {
 		return err
 	}
 	if ev.Node == nil {
+		p.timing.execDone(time.Now())
+		p.complete.Store(true)
 		err := p.event(ExecDone{})
 		p.close()
 		return err
 	}
-	var id NodeID
-	if err := id.Parse(string(*ev.Node)); err != nil {
+	id, path, err := nodePath(*ev.Node, ev.DisplayNode)
+	if err != nil {
 		return err
 	}
-	p.curNode = id
-	return p.event(NodeStart{Node: id})
+	p.curNode, p.curPath = id, path
+	p.timing.nodeStart(id, path, time.Now())
+	if err = p.event(NodeStart{Node: id, Path: path}); err != nil {
+		return err
+	}
+	return p.progressed(func(t *progressTracker) {
+		t.node(id, path).State = NodeRunning
+	})
 }
 
 func (p *Prompt) procProgress(ev *wsconn.Progress) error {
-	var id NodeID
-	if err := id.Parse(string(ev.Node)); err != nil {
+	id, path, err := nodePath(ev.Node, nil)
+	if err != nil {
 		return err
 	}
-	return p.event(NodeProg{
+	p.timing.nodeProg(id, path, int(ev.Value), int(ev.Max), time.Now())
+	err = p.event(NodeProg{
 		Node:  id,
+		Path:  path,
 		Value: int(ev.Value),
 		Max:   int(ev.Max),
 	})
+	if err != nil {
+		return err
+	}
+	return p.progressed(func(t *progressTracker) {
+		n := t.node(id, path)
+		n.State = NodeRunning
+		n.Value, n.Max = int(ev.Value), int(ev.Max)
+	})
 }
 
 func (p *Prompt) procExecuted(ev *wsconn.ExecNodeDone) error {
-	var id NodeID
-	if err := id.Parse(string(ev.Node)); err != nil {
+	id, path, err := nodePath(ev.Node, ev.DisplayNode)
+	if err != nil {
 		return err
 	}
-	if p.curNode == id {
-		p.curNode = 0
+	if p.curPath == path {
+		p.curNode, p.curPath = 0, ""
 	}
-	return p.event(NodeDone{
-		Node: id,
-		NodeResult: NodeResult{
-			Images: ev.Output.Images,
-		},
+	p.timing.nodeDone(id, path, time.Now())
+	err = p.event(NodeDone{
+		Node:       id,
+		Path:       path,
+		NodeResult: nodeResult(ev.Output),
 	})
+	if err != nil {
+		return err
+	}
+	return p.nodeFinished(id, path)
+}
+
+func (p *Prompt) procExecError(ev *wsconn.ExecError) error {
+	return p.execFailed(ExecError{
+		NodeType:      ev.NodeType,
+		Exception:     ev.Exception,
+		ExceptionType: ev.ExceptionType,
+		Traceback:     ev.Traceback,
+	}, ev.Node)
+}
+
+func (p *Prompt) procExecInterrupted(ev *wsconn.ExecInterrupted) error {
+	return p.execFailed(ExecError{
+		NodeType:    ev.NodeType,
+		Interrupted: true,
+	}, ev.Node)
+}
+
+// execFailed completes the prompt with an error. The server still sends an execution end event after it, which is ignored.
+func (p *Prompt) execFailed(e ExecError, node wsconn.NodeID) error {
+	var err error
+	if node != "" {
+		e.Node, e.Path, err = nodePath(node, nil)
+	}
+	p.curNode, p.curPath = 0, ""
+	p.timing.execDone(time.Now())
+	p.failed.Store(&e)
+	if err2 := p.event(e); err == nil {
+		err = err2
+	}
+	p.close()
+	return err
 }
 
 type Event interface {
@@ -389,20 +547,57 @@ type ExecDone struct{}
 
 func (ExecDone) isEvent() {}
 
+// ExecError is sent when the prompt execution fails or is interrupted on the server. It is also returned by Prompt.Wait.
+type ExecError struct {
+	// Node is the ID of the top-level node that failed. It is zero if the node is unknown.
+	Node NodeID
+	// Path is the full path of the failed node.
+	Path          NodePath
+	NodeType      string
+	Exception     string
+	ExceptionType string
+	Traceback     []string
+	// Interrupted is set if the execution was interrupted instead of failing.
+	Interrupted bool
+}
+
+func (ExecError) isEvent() {}
+
+func (e ExecError) Error() string {
+	var node string
+	if e.Path != "" {
+		node = fmt.Sprintf("node %s (%s): ", e.Path, e.NodeType)
+	}
+	if e.Interrupted {
+		return node + "prompt execution interrupted"
+	}
+	if e.ExceptionType == "" {
+		return node + e.Exception
+	}
+	return fmt.Sprintf("%s%s: %s", node, e.ExceptionType, e.Exception)
+}
+
 type ExecCache struct {
+	// Nodes are IDs of cached top-level nodes.
 	Nodes []NodeID
+	// Paths are full paths of all cached nodes, including nodes inside subgraphs.
+	Paths []NodePath
 }
 
 func (ExecCache) isEvent() {}
 
 type NodeStart struct {
+	// Node is the ID of the top-level node, as displayed in the UI.
 	Node NodeID
+	// Path is the full path of the executed node. It differs from Node for nodes inside subgraphs.
+	Path NodePath
 }
 
 func (NodeStart) isEvent() {}
 
 type NodeProg struct {
 	Node  NodeID
+	Path  NodePath
 	Value int
 	Max   int
 }
@@ -411,6 +606,7 @@ func (NodeProg) isEvent() {}
 
 type NodeDone struct {
 	Node NodeID
+	Path NodePath
 	NodeResult
 }
 
@@ -0,0 +1,177 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/dennwc/gocomfy/graph/types"
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+// Extensions returns paths to frontend extension scripts installed on the server.
+func (c *Client) Extensions(ctx context.Context) ([]string, error) {
+	var out []string
+	if err := c.getJSON(ctx, "/extensions", &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Features describes optional features supported by the server.
+type Features struct {
+	// PreviewMeta is set if the server can send previews with metadata.
+	PreviewMeta bool `json:"supports_preview_metadata"`
+	// MaxUploadSize is the max size of uploaded files in bytes.
+	MaxUploadSize int64 `json:"max_upload_size"`
+	// Raw contains all feature flags reported by the server.
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+// Features returns feature flags of the server. Older servers do not support this endpoint.
+func (c *Client) Features(ctx context.Context) (*Features, error) {
+	var raw map[string]json.RawMessage
+	if err := c.getJSON(ctx, "/features", &raw); err != nil {
+		return nil, err
+	}
+	out := &Features{Raw: raw}
+	for k, v := range raw {
+		var err error
+		switch k {
+		case "supports_preview_metadata":
+			err = json.Unmarshal(v, &out.PreviewMeta)
+		case "max_upload_size":
+			err = json.Unmarshal(v, &out.MaxUploadSize)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode feature %q: %w", k, err)
+		}
+	}
+	return out, nil
+}
+
+// ObjectInfo returns the definition of a single node class.
+func (c *Client) ObjectInfo(ctx context.Context, class types.NodeClass) (*classes.Class, error) {
+	rc, _, err := c.get(ctx, "/object_info/"+url.PathEscape(string(class)))
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	all, err := classes.Decode(rc)
+	if err != nil {
+		return nil, err
+	}
+	cl := all[class]
+	if cl == nil {
+		return nil, fmt.Errorf("class %q not found", class)
+	}
+	return cl, nil
+}
+
+// Capabilities describe optional APIs supported by the server.
+//
+// They are negotiated when the client connects to the websocket, or on the first use if the websocket is disabled,
+// and allow degrading gracefully across ComfyUI versions.
+type Capabilities struct {
+	// Features is set if the server supports feature flags.
+	Features bool
+	// PreviewMeta is set if the server can send previews with metadata.
+	PreviewMeta bool
+	// ProgressState is set if the server reports state of all nodes via progress_state events.
+	// It is assumed to be supported by the servers that support feature flags.
+	ProgressState bool
+	// Jobs is set if the server supports the jobs API.
+	Jobs bool
+	// Assets is set if the server supports the assets API.
+	Assets bool
+	// MaxUploadSize is the max size of uploaded files in bytes, if known.
+	MaxUploadSize int64
+}
+
+// WithCapabilities sets server capabilities, instead of negotiating them with the server.
+func WithCapabilities(caps Capabilities) ClientOption {
+	return clientOptionFunc(func(c *clientOptions) {
+		c.Caps = &caps
+	})
+}
+
+// Capabilities returns capabilities of the server. They are negotiated once, unless set with WithCapabilities.
+func (c *Client) Capabilities(ctx context.Context) (Capabilities, error) {
+	c.capsMu.Lock()
+	defer c.capsMu.Unlock()
+	if c.caps != nil {
+		return *c.caps, nil
+	}
+	caps, err := c.negotiate(ctx)
+	if err != nil {
+		return Capabilities{}, err
+	}
+	c.caps = &caps
+	return caps, nil
+}
+
+// negotiate detects capabilities of the server. Endpoints that fail are considered unsupported.
+// It stops on the first transport error, since other probes would fail the same way.
+func (c *Client) negotiate(ctx context.Context) (Capabilities, error) {
+	var caps Capabilities
+	probe := func(name string, fnc func() error) (bool, error) {
+		err := fnc()
+		if err == nil {
+			return true, nil
+		} else if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		var (
+			serr *StatusError
+			uerr *url.Error
+		)
+		if errors.As(err, &uerr) {
+			return false, fmt.Errorf("cannot detect server capabilities: %w", err)
+		} else if !errors.As(err, &serr) {
+			c.log.Warn("cannot detect server capability", "name", name, "err", err)
+		}
+		return false, nil
+	}
+	var err error
+	caps.Features, err = probe("features", func() error {
+		f, err := c.Features(ctx)
+		if err != nil {
+			return err
+		}
+		caps.PreviewMeta = f.PreviewMeta
+		caps.MaxUploadSize = f.MaxUploadSize
+		return nil
+	})
+	if err != nil {
+		return caps, err
+	}
+	caps.ProgressState = caps.Features
+	caps.Jobs, err = probe("jobs", func() error {
+		_, err := c.ListJobsPage(ctx, &ListJobsOpts{Limit: 1})
+		return err
+	})
+	if err != nil {
+		return caps, err
+	}
+	caps.Assets, err = probe("assets", func() error {
+		_, err := c.ListAssetsPage(ctx, &ListAssetsOpts{Limit: 1})
+		return err
+	})
+	if err != nil {
+		return caps, err
+	}
+	return caps, nil
+}
+
+// sendFeatureFlags reports client features to the server via the websocket.
+func (c *Client) sendFeatureFlags(caps Capabilities) error {
+	if c.conn == nil || !caps.Features {
+		return nil
+	}
+	return c.conn.WriteEvent(&wsconn.FeatureFlags{
+		"supports_preview_metadata": true,
+	})
+}
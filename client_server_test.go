@@ -0,0 +1,59 @@
+package gocomfy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestNegotiate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /features", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"supports_preview_metadata":true,"max_upload_size":1024,"extension":{"manager":{"supports_v4":true}}}`))
+	})
+	mux.HandleFunc("GET /api/jobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jobs":[]}`))
+	})
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		mux.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+	ctx := context.Background()
+
+	// capabilities are negotiated lazily without the websocket
+	c, err := NewClient(ctx, host, WithoutWebsocket())
+	must.NoError(t, err)
+	defer c.Close()
+	must.EqOp(t, 0, requests.Load())
+
+	exp := Capabilities{
+		Features:      true,
+		PreviewMeta:   true,
+		ProgressState: true,
+		Jobs:          true,
+		MaxUploadSize: 1024,
+	}
+	for range 2 {
+		caps, err := c.Capabilities(ctx)
+		must.NoError(t, err)
+		must.Eq(t, exp, caps)
+	}
+	must.EqOp(t, 3, requests.Load())
+
+	// negotiation stops on the first transport error
+	srv.Close()
+	requests.Store(0)
+	c, err = NewClient(ctx, host, WithoutWebsocket())
+	must.NoError(t, err)
+	defer c.Close()
+	_, err = c.Capabilities(ctx)
+	must.ErrorContains(t, err, "cannot detect server capabilities")
+}
@@ -50,23 +50,19 @@ func TestPrompt(t *testing.T) {
 	g, err := apigraph.ReadFile("./testdata/default_api.json")
 	must.NoError(t, err)
 
-	// set model
-	loader := g.Nodes[4]
-	must.EqOp(t, loader.Class, "CheckpointLoaderSimple")
-	loader.Inputs["ckpt_name"] = apigraph.String(model)
-
-	// get sampler for seed randomization
-	sampler := g.Nodes[3]
-	must.EqOp(t, sampler.Class, "KSampler")
-
 	// get SaveImage to swap it later
 	save := g.Nodes[9]
 	must.EqOp(t, save.Class, "SaveImage")
 	delete(save.Inputs, "filename_prefix")
+	save.Class = "PreviewImage"
+
+	tmpl := apigraph.NewTemplate(g, nil)
+	must.NoError(t, tmpl.Bind("model", apigraph.ByClass("CheckpointLoaderSimple", "ckpt_name")))
+	must.NoError(t, tmpl.Bind("seed", apigraph.ByClass("KSampler", "seed")))
 
 	c := testClient(t)
 
-	runTest := func(t *testing.T) {
+	runTest := func(t *testing.T, g *apigraph.Graph) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 		defer cancel()
 
@@ -115,8 +111,11 @@ func TestPrompt(t *testing.T) {
 	}
 
 	t.Run("PreviewImage", func(t *testing.T) {
-		sampler.Inputs["seed"] = apigraph.Int(rand.Uint32())
-		save.Class = "PreviewImage"
-		runTest(t)
+		g, err := tmpl.Instantiate(map[string]any{
+			"model": model,
+			"seed":  rand.Uint32(),
+		})
+		must.NoError(t, err)
+		runTest(t, g)
 	})
 }
@@ -0,0 +1,313 @@
+package gocomfy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path"
+	"strings"
+)
+
+type uploadOptions struct {
+	Size     int64
+	Progress func(sent, total int64)
+	Dedupe   bool
+}
+
+type UploadOption interface {
+	applyToUpload(o *uploadOptions)
+}
+
+type uploadOptionFunc func(o *uploadOptions)
+
+func (f uploadOptionFunc) applyToUpload(o *uploadOptions) {
+	f(o)
+}
+
+// WithUploadSize sets the size of the uploaded file.
+//
+// It is only required for readers with unknown size. Size is detected automatically for files, seekers and in-memory readers.
+func WithUploadSize(size int64) UploadOption {
+	return uploadOptionFunc(func(o *uploadOptions) {
+		o.Size = size
+	})
+}
+
+// WithUploadProgress sets a callback that is called as the file is being uploaded.
+// Total is set to -1 if the size of the file is unknown.
+func WithUploadProgress(fnc func(sent, total int64)) UploadOption {
+	return uploadOptionFunc(func(o *uploadOptions) {
+		o.Progress = fnc
+	})
+}
+
+// WithUploadDedupe enables content-based deduplication of uploads.
+//
+// Before uploading, the client compares the hash of the content with the file with the same name on the server.
+// If they match, the upload is skipped and the existing reference is returned.
+// Deduplication requires the reader to implement io.Seeker, otherwise the option is ignored.
+func WithUploadDedupe() UploadOption {
+	return uploadOptionFunc(func(o *uploadOptions) {
+		o.Dedupe = true
+	})
+}
+
+// UploadFile uploads an arbitrary input file to ComfyUI.
+//
+// It can be used for images, videos, audio and 3D models consumed by nodes like LoadImage, LoadVideo or LoadAudio.
+// Content type of the file is detected from the file contents or its extension.
+// Returned reference can be passed to loader nodes via ImageRef.AnnotatedPath.
+//
+// The file is streamed to the server without buffering it in memory.
+func (c *Client) UploadFile(ctx context.Context, ref ImageRef, r io.Reader, overwrite bool, opts ...UploadOption) (*ImageRef, error) {
+	return c.uploadFile(ctx, "/upload/image", ref, nil, r, overwrite, opts)
+}
+
+// UploadMask uploads a mask for an existing image. Mask is applied to the alpha channel of the original image.
+func (c *Client) UploadMask(ctx context.Context, ref ImageRef, original ImageRef, r io.Reader, overwrite bool, opts ...UploadOption) (*ImageRef, error) {
+	return c.uploadFile(ctx, "/upload/mask", ref, &original, r, overwrite, opts)
+}
+
+func (c *Client) uploadFile(ctx context.Context, path string, ref ImageRef, original *ImageRef, r io.Reader, overwrite bool, opts []UploadOption) (*ImageRef, error) {
+	opt := uploadOptions{Size: -1}
+	for _, o := range opts {
+		o.applyToUpload(&opt)
+	}
+	if opt.Dedupe {
+		if rs, ok := r.(io.ReadSeeker); ok {
+			found, err := c.hasSameFile(ctx, ref, rs)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				if ref.Type == "" {
+					ref.Type = ImageInput
+				}
+				return &ref, nil
+			}
+		} else {
+			c.log.Debug("upload deduplication requires a seeker", "filename", ref.Filename)
+		}
+	}
+	var fields []formField
+	if ref.Type != "" {
+		fields = append(fields, formField{"type", string(ref.Type)})
+	}
+	if ref.Subfolder != "" {
+		fields = append(fields, formField{"subfolder", ref.Subfolder})
+	}
+	if overwrite {
+		fields = append(fields, formField{"overwrite", "true"})
+	}
+	if original != nil {
+		data, err := json.Marshal(struct {
+			Filename  string    `json:"filename"`
+			Subfolder string    `json:"subfolder"`
+			Type      ImageType `json:"type"`
+		}{
+			Filename:  original.Filename,
+			Subfolder: original.Subfolder,
+			Type:      original.Type,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, formField{"original_ref", string(data)})
+	}
+	var out struct {
+		Filename  string    `json:"name"`
+		Subfolder string    `json:"subfolder"`
+		Type      ImageType `json:"type"`
+	}
+	if err := c.postFile(ctx, path, fields, "image", ref.Filename, r, &opt, &out); err != nil {
+		return nil, err
+	}
+	return &ImageRef{
+		Filename:  out.Filename,
+		Subfolder: out.Subfolder,
+		Type:      out.Type,
+	}, nil
+}
+
+type formField struct {
+	Name  string
+	Value string
+}
+
+// postFile streams a multipart form with given fields and a file to the server, and decodes the JSON response.
+func (c *Client) postFile(ctx context.Context, path string, fields []formField, field, filename string, r io.Reader, opt *uploadOptions, out any) error {
+	if opt.Size < 0 {
+		opt.Size = readerSize(r)
+	}
+	ctype, r, err := detectContentType(filename, r)
+	if err != nil {
+		return err
+	}
+	// Multipart body is split into the header, the file content and the trailer.
+	// This allows streaming the file and computing the content length upfront.
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	for _, f := range fields {
+		if err = form.WriteField(f.Name, f.Value); err != nil {
+			return err
+		}
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", ctype)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, field, filename))
+	if _, err = form.CreatePart(h); err != nil {
+		return err
+	}
+	hsz := buf.Len()
+	if err = form.Close(); err != nil {
+		return err
+	}
+	head, trailer := buf.Bytes()[:hsz], buf.Bytes()[hsz:]
+
+	size := int64(-1)
+	if opt.Size >= 0 {
+		size = int64(len(head)) + opt.Size + int64(len(trailer))
+	}
+	if opt.Progress != nil {
+		r = &progressReader{r: r, total: opt.Size, fnc: opt.Progress}
+	}
+	body := io.MultiReader(bytes.NewReader(head), r, bytes.NewReader(trailer))
+
+	addr := fmt.Sprintf("http://%s%s", c.host, path)
+	req, err := http.NewRequestWithContext(ctx, "POST", addr, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	resp, err := c.hcli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &StatusError{Code: resp.StatusCode}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// hasSameFile checks if the server already has a file with the same name and content.
+// The reader is rewound to its original position after the check.
+func (c *Client) hasSameFile(ctx context.Context, ref ImageRef, r io.ReadSeeker) (bool, error) {
+	if ref.Type == "" {
+		ref.Type = ImageInput
+	}
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, err
+	}
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if _, serr := r.Seek(start, io.SeekStart); err == nil {
+		err = serr
+	}
+	if err != nil {
+		return false, err
+	}
+	local := h.Sum(nil)
+
+	rc, _, err := c.GetImageFile(ctx, ref)
+	if isNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+	h.Reset()
+	if _, err = io.Copy(h, rc); err != nil {
+		return false, err
+	}
+	return bytes.Equal(local, h.Sum(nil)), nil
+}
+
+// readerSize returns the number of bytes remaining in the reader, or -1 if it is unknown.
+func readerSize(r io.Reader) int64 {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len())
+	case *os.File:
+		st, err := r.Stat()
+		if err != nil || !st.Mode().IsRegular() {
+			return -1
+		}
+		cur, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		return st.Size() - cur
+	case io.Seeker:
+		cur, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return -1
+		}
+		if _, err = r.Seek(cur, io.SeekStart); err != nil {
+			return -1
+		}
+		return end - cur
+	}
+	return -1
+}
+
+type progressReader struct {
+	r     io.Reader
+	sent  int64
+	total int64
+	fnc   func(sent, total int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.fnc(r.sent, r.total)
+	}
+	return n, err
+}
+
+// detectContentType sniffs the content type from the first bytes of the reader.
+// If the content cannot be recognized, the file extension is used instead.
+//
+// It returns a new reader that must be used instead of the original one.
+func detectContentType(name string, r io.Reader) (string, io.Reader, error) {
+	br := bufio.NewReaderSize(r, 512)
+	head, err := br.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return "", nil, err
+	}
+	typ := http.DetectContentType(head)
+	if !isGenericContentType(typ) {
+		return typ, br, nil
+	}
+	if t := mime.TypeByExtension(strings.ToLower(path.Ext(name))); t != "" {
+		return t, br, nil
+	}
+	return typ, br, nil
+}
+
+func isGenericContentType(typ string) bool {
+	typ, _, _ = strings.Cut(typ, ";")
+	switch typ {
+	case "application/octet-stream", "text/plain":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package gocomfy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+func fakeClient(t testing.TB, h http.Handler, opts ...ClientOption) *Client {
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	opts = append([]ClientOption{WithoutWebsocket(), WithCapabilities(Capabilities{})}, opts...)
+	c, err := NewClient(context.Background(), strings.TrimPrefix(srv.URL, "http://"), opts...)
+	must.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c
+}
+
+// fakeWSClient creates a client for a fake server with a websocket endpoint.
+// Events sent to the channel are forwarded to the client via the websocket.
+func fakeWSClient(t testing.TB, h http.Handler, events <-chan wsconn.Event, opts ...ClientOption) *Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ws", func(w http.ResponseWriter, r *http.Request) {
+		var up websocket.Upgrader
+		c, err := up.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		conn := wsconn.NewConn(c)
+		defer conn.Close()
+		for ev := range events {
+			if err = conn.WriteEvent(ev); err != nil {
+				return
+			}
+		}
+	})
+	mux.Handle("/", h)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	opts = append([]ClientOption{WithCapabilities(Capabilities{})}, opts...)
+	c, err := NewClient(context.Background(), strings.TrimPrefix(srv.URL, "http://"), opts...)
+	must.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c
+}
+
+// sendPromptEvents waits for the client to register the prompt and sends events for it.
+func sendPromptEvents(c *Client, pid string, out chan<- wsconn.Event, events ...wsconn.PromptEvent) {
+	for c.getPrompt(pid) == nil {
+		time.Sleep(time.Millisecond)
+	}
+	for _, ev := range events {
+		out <- ev
+	}
+}
+
+func TestUploadFile(t *testing.T) {
+	content := []byte("RIFF\x00\x00\x00\x00WAVEfmt some audio")
+	var (
+		uploads int
+		stored  = make(map[string][]byte)
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /upload/image", func(w http.ResponseWriter, r *http.Request) {
+		uploads++
+		must.Positive(t, r.ContentLength)
+		f, fh, err := r.FormFile("image")
+		must.NoError(t, err)
+		defer f.Close()
+		must.EqOp(t, "audio/wave", fh.Header.Get("Content-Type"))
+		data, err := io.ReadAll(f)
+		must.NoError(t, err)
+		stored[fh.Filename] = data
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"name": fh.Filename, "subfolder": r.FormValue("subfolder"), "type": "input",
+		})
+	})
+	mux.HandleFunc("GET /view", func(w http.ResponseWriter, r *http.Request) {
+		data, ok := stored[r.URL.Query().Get("filename")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	})
+	c := fakeClient(t, mux)
+	ctx := context.Background()
+
+	var sent, total int64
+	ref, err := c.UploadFile(ctx, ImageRef{Filename: "a.wav", Subfolder: "audio"}, bytes.NewReader(content), false,
+		WithUploadDedupe(),
+		WithUploadProgress(func(s, t int64) {
+			sent, total = s, t
+		}),
+	)
+	must.NoError(t, err)
+	must.EqOp(t, "audio/a.wav [input]", ref.AnnotatedPath())
+	must.EqOp(t, int64(len(content)), sent)
+	must.EqOp(t, int64(len(content)), total)
+	must.Eq(t, content, stored["a.wav"])
+	must.EqOp(t, 1, uploads)
+
+	_, err = c.UploadFile(ctx, ImageRef{Filename: "a.wav", Subfolder: "audio"}, bytes.NewReader(content), false, WithUploadDedupe())
+	must.NoError(t, err)
+	must.EqOp(t, 1, uploads)
+}
@@ -92,14 +92,10 @@ func codeFromAPI(w io.Writer, pkg string, inputPath string) error {
 			}
 		}
 	}
-	var roots []types.NodeID
-	for id, u := range usage {
-		if u.Out == 0 {
-			roots = append(roots, id)
-		}
+	order, err := g.TopoSort()
+	if err != nil {
+		return err
 	}
-	slices.Sort(roots)
-	seen := make(map[types.NodeID]struct{})
 	bw.WriteString("package ")
 	bw.WriteString(pkg)
 	bw.WriteString("\n")
@@ -112,21 +108,14 @@ func Workflow() *apinodes.Graph {
 	g := apinodes.New()
 `)
 	defer bw.WriteString("\treturn g\n}\n")
-	for _, root := range roots {
-		n := g.Nodes[root]
-		if n == nil {
-			continue
-		}
-		codeFromAPINode(bw, g, n, usage, seen)
+	for _, id := range order {
+		codeFromAPINode(bw, g.Nodes[id], usage)
 	}
 	return nil
 }
 
-func codeFromAPINode(w *bufio.Writer, g *apigraph.Graph, n *apigraph.Node, usage map[types.NodeID]*nodeUsage, seen map[types.NodeID]struct{}) {
-	if _, ok := seen[n.ID]; ok {
-		return
-	}
-	seen[n.ID] = struct{}{}
+// codeFromAPINode writes a constructor call for the node. Nodes must be written in topological order.
+func codeFromAPINode(w *bufio.Writer, n *apigraph.Node, usage map[types.NodeID]*nodeUsage) {
 	u := usage[n.ID]
 	c := apinodes.ClassByName[n.Class]
 	if c == nil {
@@ -140,20 +129,6 @@ func codeFromAPINode(w *bufio.Writer, g *apigraph.Graph, n *apigraph.Node, usage
 			c.Outputs = append(c.Outputs, classes.Output{})
 		}
 	}
-	for _, p := range c.Inputs {
-		if p.Kind == classes.InputHidden {
-			continue
-		}
-		v, ok := n.Inputs[p.Name].(apigraph.Link)
-		if !ok {
-			continue
-		}
-		n2 := g.Nodes[v.NodeID]
-		if n2 == nil {
-			continue
-		}
-		codeFromAPINode(w, g, n2, usage, seen)
-	}
 	w.WriteString("\t")
 	if len(c.Outputs) != 0 && u.Out != 0 {
 		w.WriteString("_")
@@ -178,6 +153,9 @@ func codeFromAPINode(w *bufio.Writer, g *apigraph.Graph, n *apigraph.Node, usage
 				w.WriteString("_")
 			}
 		}
+		if c.IsOutput {
+			w.WriteString(", _") // output handle
+		}
 		w.WriteString(" := ")
 	}
 	w.WriteString("apinodes.")
@@ -224,4 +202,8 @@ func codeFromAPINode(w *bufio.Writer, g *apigraph.Graph, n *apigraph.Node, usage
 		}
 		input(&p)
 	}
+	// ComfyUI always exports titles, so only emit custom ones
+	if title := n.Title(); title != "" && title != c.Title {
+		fmt.Fprintf(w, ", apinodes.Title(%q)", title)
+	}
 }
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+	cli "github.com/urfave/cli/v3"
+)
+
+func init() {
+	cmd := &cli.Command{
+		Name:      "diff",
+		Usage:     "Compare two workflows in API format",
+		ArgsUsage: "<old.json> <new.json>",
+	}
+	Root.Commands = append(Root.Commands, cmd)
+	cmd.Action = func(ctx context.Context, cmd *cli.Command) error {
+		if cmd.NArg() != 2 {
+			return errors.New("expected two workflow files")
+		}
+		return diffWorkflows(os.Stdout, cmd.Args().Get(0), cmd.Args().Get(1))
+	}
+}
+
+func diffWorkflows(w io.Writer, oldPath, newPath string) error {
+	a, err := apigraph.ReadFile(oldPath)
+	if err != nil {
+		return err
+	}
+	b, err := apigraph.ReadFile(newPath)
+	if err != nil {
+		return err
+	}
+	d, err := apigraph.Diff(a, b)
+	if err != nil {
+		return err
+	}
+	if d.IsEmpty() {
+		_, err = fmt.Fprintln(w, "no changes")
+		return err
+	}
+	_, err = io.WriteString(w, d.String())
+	return err
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+	cli "github.com/urfave/cli/v3"
+)
+
+func init() {
+	var flags struct {
+		Write     bool
+		Renumber  bool
+		StripMeta bool
+	}
+	cmd := &cli.Command{
+		Name:      "fmt",
+		Usage:     "Format workflow files in API format",
+		ArgsUsage: "[files...]",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:        "write",
+				Aliases:     []string{"w"},
+				Usage:       "Write the result to the source file instead of stdout",
+				Destination: &flags.Write,
+			},
+			&cli.BoolFlag{
+				Name:        "renumber",
+				Usage:       "Renumber nodes in topological order",
+				Destination: &flags.Renumber,
+			},
+			&cli.BoolFlag{
+				Name:        "strip-meta",
+				Usage:       "Remove node metadata such as titles",
+				Destination: &flags.StripMeta,
+			},
+		},
+	}
+	Root.Commands = append(Root.Commands, cmd)
+	cmd.Action = func(ctx context.Context, cmd *cli.Command) error {
+		opts := &apigraph.FormatOptions{StripMeta: flags.StripMeta}
+		if cmd.NArg() == 0 {
+			if flags.Write {
+				return fmt.Errorf("cannot use -w with stdin")
+			}
+			return formatWorkflow(os.Stdout, os.Stdin, flags.Renumber, opts)
+		}
+		for _, path := range cmd.Args().Slice() {
+			if err := formatWorkflowFile(path, flags.Write, flags.Renumber, opts); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		return nil
+	}
+}
+
+func formatWorkflow(w io.Writer, r io.Reader, renumber bool, opts *apigraph.FormatOptions) error {
+	g, err := apigraph.Read(r)
+	if err != nil {
+		return err
+	}
+	if renumber {
+		if _, err = g.Canonicalize(); err != nil {
+			return err
+		}
+	}
+	return apigraph.Format(w, g, opts)
+}
+
+func formatWorkflowFile(path string, write, renumber bool, opts *apigraph.FormatOptions) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err = formatWorkflow(&buf, bytes.NewReader(data), renumber, opts); err != nil {
+		return err
+	}
+	if !write {
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	if bytes.Equal(data, buf.Bytes()) {
+		return nil
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+	"github.com/dennwc/gocomfy/graph/apinodes"
+	"github.com/dennwc/gocomfy/graph/classes"
+	cli "github.com/urfave/cli/v3"
+)
+
+func init() {
+	var flags struct {
+		Input   string
+		Format  string
+		Classes string
+	}
+	cmd := &cli.Command{
+		Name:  "graph",
+		Usage: "Render workflow in API format as a Graphviz or Mermaid diagram",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "input",
+				Aliases:     []string{"i"},
+				Usage:       "Input JSON file (API format)",
+				Destination: &flags.Input,
+			},
+			&cli.StringFlag{
+				Name:        "format",
+				Aliases:     []string{"f"},
+				Usage:       "Output format: dot or mermaid",
+				Value:       "dot",
+				Destination: &flags.Format,
+			},
+			&cli.StringFlag{
+				Name:        "classes",
+				Usage:       "Node class definitions (object_info JSON), builtin classes are used by default",
+				Destination: &flags.Classes,
+			},
+		},
+	}
+	Root.Commands = append(Root.Commands, cmd)
+	cmd.Action = func(ctx context.Context, cmd *cli.Command) error {
+		return renderGraph(os.Stdout, flags.Input, flags.Format, flags.Classes)
+	}
+}
+
+func renderGraph(w io.Writer, inputPath, format, classesPath string) error {
+	var write func(w io.Writer, g *apigraph.Graph, cls classes.Classes) error
+	switch format {
+	case "dot":
+		write = apigraph.WriteDOT
+	case "mermaid":
+		write = apigraph.WriteMermaid
+	default:
+		return fmt.Errorf("unsupported format: %q", format)
+	}
+	g, err := apigraph.ReadFile(inputPath)
+	if err != nil {
+		return err
+	}
+	cls := apinodes.ClassByName
+	if classesPath != "" {
+		f, err := os.Open(classesPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		cls, err = classes.Decode(f)
+		if err != nil {
+			return err
+		}
+	}
+	return write(w, g, cls)
+}
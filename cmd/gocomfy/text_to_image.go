@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
-	"io"
+	"fmt"
 	"math/rand"
-	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/urfave/cli/v3"
 
+	"github.com/dennwc/gocomfy"
 	api "github.com/dennwc/gocomfy/graph/apinodes"
 )
 
@@ -111,31 +113,21 @@ func init() {
 				sampler, "normal", 1,
 			)
 			_, outImg := api.VAEDecode(g, outLatent, vae)
-			out := api.PreviewImage(g, outImg)
+			api.PreviewImage(g, outImg)
 
-			res, err := c.RunPrompt(ctx, g)
+			sink, err := gocomfy.NewDirSink(filepath.Dir(outFile), outputName(filepath.Base(outFile)))
 			if err != nil {
 				return err
 			}
-			outRes := res[out.ID]
-
-			rc, _, err := c.GetImageFile(ctx, outRes.Images[0])
-			if err != nil {
-				return err
-			}
-			defer rc.Close()
-
-			f, err := os.Create(outFile)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			_, err = io.Copy(f, rc)
-			if err != nil {
-				return err
-			}
-			return f.Close()
+			_, err = c.RunPromptTo(ctx, g, sink)
+			return err
 		},
 	})
 }
+
+// outputName returns a DirSink name template that writes the first output to a given file,
+// and the rest next to it, with an index suffix. The name is quoted, so it's not interpreted as a template.
+func outputName(name string) string {
+	ext := filepath.Ext(name)
+	return fmt.Sprintf(`{{if eq .Index 0}}{{%q}}{{else}}{{%q}}_{{.Index}}{{%q}}{{end}}`, name, strings.TrimSuffix(name, ext), ext)
+}
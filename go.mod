@@ -1,6 +1,6 @@
 module github.com/dennwc/gocomfy
 
-go 1.22
+go 1.23
 
 require (
 	github.com/google/uuid v1.6.0
@@ -9,6 +9,7 @@ require (
 	github.com/shoenig/test v1.8.2
 	github.com/urfave/cli/v3 v3.0.0-alpha9
 	golang.org/x/exp v0.0.0-20240613232115-7f521ea00fb8
+	golang.org/x/image v0.18.0
 )
 
 require (
@@ -0,0 +1,256 @@
+package apigraph
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+// NodeRef identifies a node in the diff.
+type NodeRef struct {
+	ID    types.NodeID
+	Class types.NodeClass
+	Title string
+}
+
+func nodeRef(n *Node) NodeRef {
+	return NodeRef{ID: n.ID, Class: n.Class, Title: n.Title()}
+}
+
+func (r NodeRef) String() string {
+	if r.Title == "" || r.Title == string(r.Class) {
+		return fmt.Sprintf("#%v (%s)", r.ID, r.Class)
+	}
+	return fmt.Sprintf("#%v (%s %q)", r.ID, r.Class, r.Title)
+}
+
+// InputDiff is a change of a single node input. Old or New value is nil if the input is not set.
+type InputDiff struct {
+	Name string
+	Old  Value
+	New  Value
+}
+
+// NodeDiff lists changes between two matched nodes.
+type NodeDiff struct {
+	Old    NodeRef
+	New    NodeRef
+	Inputs []InputDiff
+}
+
+// GraphDiff is a structural difference between two graphs.
+type GraphDiff struct {
+	// Matched maps node IDs of the old graph to matching nodes in the new graph.
+	Matched map[types.NodeID]types.NodeID
+	// Added are nodes of the new graph without a match in the old graph.
+	Added []NodeRef
+	// Removed are nodes of the old graph without a match in the new graph.
+	Removed []NodeRef
+	// Changed are matched nodes with different inputs or titles.
+	Changed []NodeDiff
+}
+
+// IsEmpty checks if the graphs are structurally equal.
+func (d *GraphDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String returns a human-readable summary of the diff.
+func (d *GraphDiff) String() string {
+	var sb strings.Builder
+	for _, n := range d.Removed {
+		fmt.Fprintf(&sb, "- %s\n", n)
+	}
+	for _, n := range d.Added {
+		fmt.Fprintf(&sb, "+ %s\n", n)
+	}
+	for _, c := range d.Changed {
+		if c.Old.ID == c.New.ID {
+			fmt.Fprintf(&sb, "~ %s\n", c.New)
+		} else {
+			fmt.Fprintf(&sb, "~ %s -> %s\n", c.Old, c.New)
+		}
+		if c.Old.Title != c.New.Title {
+			fmt.Fprintf(&sb, "    title: %q -> %q\n", c.Old.Title, c.New.Title)
+		}
+		for _, in := range c.Inputs {
+			fmt.Fprintf(&sb, "    %s: %s -> %s\n", in.Name, formatValue(in.Old), formatValue(in.New))
+		}
+	}
+	return sb.String()
+}
+
+func formatValue(v Value) string {
+	switch v := v.(type) {
+	case nil:
+		return "<unset>"
+	case Link:
+		return fmt.Sprintf("#%v:%d", v.NodeID, v.OutPort)
+	case String:
+		return fmt.Sprintf("%q", string(v))
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Diff compares two graphs structurally, ignoring node IDs.
+//
+// Nodes are matched in multiple passes: first by the full canonical hash (see Graph.NodeHashes),
+// then by the class, title and the shape of upstream nodes, then by the class and the shape,
+// and finally by the class and title, or by the class if it's unique among unmatched nodes.
+// Links are considered equal if they point to the same output port of matched nodes.
+func Diff(a, b *Graph) (*GraphDiff, error) {
+	ma, err := newDiffSide(a)
+	if err != nil {
+		return nil, fmt.Errorf("old graph: %w", err)
+	}
+	mb, err := newDiffSide(b)
+	if err != nil {
+		return nil, fmt.Errorf("new graph: %w", err)
+	}
+	d := &GraphDiff{Matched: make(map[types.NodeID]types.NodeID)}
+	passes := []func(s *diffSide, id types.NodeID) string{
+		func(s *diffSide, id types.NodeID) string {
+			return s.full[id].String()
+		},
+		func(s *diffSide, id types.NodeID) string {
+			return s.shape[id].String() + "\x00" + s.g.Nodes[id].Title()
+		},
+		func(s *diffSide, id types.NodeID) string {
+			return s.shape[id].String()
+		},
+		func(s *diffSide, id types.NodeID) string {
+			n := s.g.Nodes[id]
+			return string(n.Class) + "\x00" + n.Title()
+		},
+	}
+	for _, key := range passes {
+		matchNodes(d, ma, mb, key, false)
+	}
+	matchNodes(d, ma, mb, func(s *diffSide, id types.NodeID) string {
+		return string(s.g.Nodes[id].Class)
+	}, true)
+
+	for _, id := range ma.order {
+		if _, ok := d.Matched[id]; !ok {
+			d.Removed = append(d.Removed, nodeRef(a.Nodes[id]))
+		}
+	}
+	matchedB := make(map[types.NodeID]struct{}, len(d.Matched))
+	for _, id := range d.Matched {
+		matchedB[id] = struct{}{}
+	}
+	for _, id := range mb.order {
+		if _, ok := matchedB[id]; !ok {
+			d.Added = append(d.Added, nodeRef(b.Nodes[id]))
+		}
+	}
+	for _, id := range ma.order {
+		bid, ok := d.Matched[id]
+		if !ok {
+			continue
+		}
+		na, nb := a.Nodes[id], b.Nodes[bid]
+		nd := NodeDiff{Old: nodeRef(na), New: nodeRef(nb)}
+		names := slices.Collect(maps.Keys(na.Inputs))
+		for name := range nb.Inputs {
+			if _, ok := na.Inputs[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			va, vb := na.Inputs[name], nb.Inputs[name]
+			if !d.sameValue(va, vb) {
+				nd.Inputs = append(nd.Inputs, InputDiff{Name: name, Old: va, New: vb})
+			}
+		}
+		if len(nd.Inputs) != 0 || nd.Old.Title != nd.New.Title {
+			d.Changed = append(d.Changed, nd)
+		}
+	}
+	return d, nil
+}
+
+func (d *GraphDiff) sameValue(a, b Value) bool {
+	la, ok1 := a.(Link)
+	lb, ok2 := b.(Link)
+	if ok1 || ok2 {
+		if !ok1 || !ok2 {
+			return false
+		}
+		id, ok := d.Matched[la.NodeID]
+		return ok && id == lb.NodeID && la.OutPort == lb.OutPort
+	}
+	return a == b
+}
+
+type diffSide struct {
+	g     *Graph
+	order []types.NodeID
+	full  map[types.NodeID]Hash
+	shape map[types.NodeID]Hash
+}
+
+func newDiffSide(g *Graph) (*diffSide, error) {
+	order, err := g.TopoSort()
+	if err != nil {
+		return nil, err
+	}
+	full, err := g.NodeHashes()
+	if err != nil {
+		return nil, err
+	}
+	hs := &nodeHasher{
+		g:      g,
+		hashes: make(map[types.NodeID]Hash, len(g.Nodes)),
+		active: make(map[types.NodeID]struct{}),
+		shape:  true,
+	}
+	for _, id := range order {
+		if _, err = hs.hash(id); err != nil {
+			return nil, err
+		}
+	}
+	return &diffSide{g: g, order: order, full: full, shape: hs.hashes}, nil
+}
+
+// matchNodes pairs unmatched nodes with the same key in topological order.
+// If unique is set, nodes are only matched if the key is unique among unmatched nodes on both sides.
+func matchNodes(d *GraphDiff, a, b *diffSide, key func(s *diffSide, id types.NodeID) string, unique bool) {
+	matchedB := make(map[types.NodeID]struct{}, len(d.Matched))
+	for _, id := range d.Matched {
+		matchedB[id] = struct{}{}
+	}
+	cands := make(map[string][]types.NodeID)
+	for _, id := range b.order {
+		if _, ok := matchedB[id]; !ok {
+			k := key(b, id)
+			cands[k] = append(cands[k], id)
+		}
+	}
+	var keysA map[string]int
+	if unique {
+		keysA = make(map[string]int)
+		for _, id := range a.order {
+			if _, ok := d.Matched[id]; !ok {
+				keysA[key(a, id)]++
+			}
+		}
+	}
+	for _, id := range a.order {
+		if _, ok := d.Matched[id]; ok {
+			continue
+		}
+		k := key(a, id)
+		list := cands[k]
+		if len(list) == 0 || (unique && (len(list) != 1 || keysA[k] != 1)) {
+			continue
+		}
+		d.Matched[id] = list[0]
+		cands[k] = list[1:]
+	}
+}
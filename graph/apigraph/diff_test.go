@@ -0,0 +1,53 @@
+package apigraph
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dennwc/gocomfy/graph/types"
+	"github.com/shoenig/test/must"
+)
+
+func TestDiff(t *testing.T) {
+	a, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+
+	d, err := Diff(a, a.Clone())
+	must.NoError(t, err)
+	must.True(t, d.IsEmpty())
+	must.MapLen(t, len(a.Nodes), d.Matched)
+
+	// renumber all nodes, which should not affect the diff
+	b := New()
+	b.LastID = 100
+	ids, err := b.Import(a)
+	must.NoError(t, err)
+	d, err = Diff(a, b)
+	must.NoError(t, err)
+	must.True(t, d.IsEmpty())
+	must.Eq(t, ids, d.Matched)
+
+	// change the seed, rewire the preview and add a new node
+	b.Nodes[ids[3]].Inputs["seed"] = Int(42)
+	up := b.Add(&Node{Class: "ImageScale", Inputs: map[string]Value{
+		"image": Link{NodeID: ids[8], OutPort: 0},
+	}})
+	b.Nodes[ids[9]].Inputs["images"] = Link{NodeID: up, OutPort: 0}
+	b.Remove(ids[5])
+
+	d, err = Diff(a, b)
+	must.NoError(t, err)
+	must.False(t, d.IsEmpty())
+	must.Eq(t, []NodeRef{{ID: 5, Class: "EmptyLatentImage", Title: "Empty Latent Image"}}, d.Removed)
+	must.Eq(t, []NodeRef{{ID: up, Class: "ImageScale"}}, d.Added)
+	must.Len(t, 2, d.Changed)
+	must.Eq(t, []InputDiff{
+		{Name: "latent_image", Old: Link{NodeID: 5, OutPort: 0}},
+		{Name: "seed", Old: a.Nodes[3].Inputs["seed"], New: Int(42)},
+	}, d.Changed[0].Inputs)
+	must.EqOp(t, types.NodeID(9), d.Changed[1].Old.ID)
+	must.Eq(t, []InputDiff{
+		{Name: "images", Old: Link{NodeID: 8, OutPort: 0}, New: Link{NodeID: up, OutPort: 0}},
+	}, d.Changed[1].Inputs)
+	must.StrContains(t, d.String(), "    seed: 156680208700286 -> 42\n")
+}
@@ -0,0 +1,185 @@
+package apigraph
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+// Clone returns a deep copy of the node.
+func (n *Node) Clone() *Node {
+	inputs := make(map[string]Value, len(n.Inputs))
+	maps.Copy(inputs, n.Inputs)
+	return &Node{
+		ID:     n.ID,
+		Class:  n.Class,
+		Inputs: inputs,
+		Meta:   slices.Clone(n.Meta),
+	}
+}
+
+// Clone returns a deep copy of the graph.
+func (g *Graph) Clone() *Graph {
+	g2 := &Graph{
+		LastID: g.LastID,
+		Nodes:  make(map[types.NodeID]*Node, len(g.Nodes)),
+	}
+	for id, n := range g.Nodes {
+		g2.Nodes[id] = n.Clone()
+	}
+	return g2
+}
+
+// CloneNode adds a copy of the node to the graph. Inputs of the copy are linked to the same nodes as the original.
+func (g *Graph) CloneNode(id types.NodeID) (types.NodeID, error) {
+	n := g.Nodes[id]
+	if n == nil {
+		return 0, fmt.Errorf("node %v not found", id)
+	}
+	return g.Add(n.Clone()), nil
+}
+
+// Find returns all nodes matching the predicate, ordered by ID.
+func (g *Graph) Find(fnc func(n *Node) bool) []*Node {
+	var out []*Node
+	for _, id := range slices.Sorted(maps.Keys(g.Nodes)) {
+		if n := g.Nodes[id]; fnc(n) {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// FindByClass returns all nodes of a given class, ordered by ID.
+func (g *Graph) FindByClass(class types.NodeClass) []*Node {
+	return g.Find(func(n *Node) bool {
+		return n.Class == class
+	})
+}
+
+// FindByTitle returns all nodes with a given title in the metadata, ordered by ID.
+func (g *Graph) FindByTitle(title string) []*Node {
+	return g.Find(func(n *Node) bool {
+		return n.Title() == title
+	})
+}
+
+// NodeByTitle returns a single node with a given title in the metadata.
+// It returns an error if no nodes or multiple nodes have this title.
+func (g *Graph) NodeByTitle(title string) (*Node, error) {
+	nodes := g.FindByTitle(title)
+	switch len(nodes) {
+	case 0:
+		return nil, fmt.Errorf("node with title %q not found", title)
+	case 1:
+		return nodes[0], nil
+	default:
+		return nil, fmt.Errorf("multiple nodes with title %q", title)
+	}
+}
+
+// Consumers returns all node inputs linked to a given output port.
+func (g *Graph) Consumers(out Link) []InputRef {
+	return g.consumers(func(l Link) bool {
+		return l == out
+	})
+}
+
+// NodeConsumers returns all node inputs linked to any output of a given node.
+func (g *Graph) NodeConsumers(id types.NodeID) []InputRef {
+	return g.consumers(func(l Link) bool {
+		return l.NodeID == id
+	})
+}
+
+func (g *Graph) consumers(fnc func(l Link) bool) []InputRef {
+	var out []InputRef
+	for _, id := range slices.Sorted(maps.Keys(g.Nodes)) {
+		n := g.Nodes[id]
+		for _, name := range slices.Sorted(maps.Keys(n.Inputs)) {
+			if l, ok := n.Inputs[name].(Link); ok && fnc(l) {
+				out = append(out, InputRef{Node: id, Input: name})
+			}
+		}
+	}
+	return out
+}
+
+// Remove deletes the node from the graph, together with all inputs linked to it.
+// It returns inputs of other nodes that were disconnected.
+func (g *Graph) Remove(id types.NodeID) []InputRef {
+	if g.Nodes[id] == nil {
+		return nil
+	}
+	delete(g.Nodes, id)
+	refs := g.NodeConsumers(id)
+	for _, ref := range refs {
+		delete(g.Nodes[ref.Node].Inputs, ref.Input)
+	}
+	return refs
+}
+
+// Redirect relinks all inputs connected to one output port to another port. Nodes in the skip list are not changed.
+// It returns the number of relinked inputs.
+func (g *Graph) Redirect(from, to Link, skip ...types.NodeID) int {
+	cnt := 0
+	for _, ref := range g.Consumers(from) {
+		if slices.Contains(skip, ref.Node) {
+			continue
+		}
+		g.Nodes[ref.Node].Inputs[ref.Input] = to
+		cnt++
+	}
+	return cnt
+}
+
+// Insert adds the node into an existing link. The node input is connected to the link,
+// and all other consumers of the link are connected to the output port of the new node.
+//
+// For example, a LoRA loader can be inserted between the checkpoint loader and the sampler this way.
+func (g *Graph) Insert(from Link, n *Node, input string, out int) (types.NodeID, error) {
+	if g.Nodes[from.NodeID] == nil {
+		return 0, fmt.Errorf("node %v not found", from.NodeID)
+	}
+	if n.Inputs == nil {
+		n.Inputs = make(map[string]Value)
+	}
+	n.Inputs[input] = from
+	id := g.Add(n)
+	g.Redirect(from, Link{NodeID: id, OutPort: out}, id)
+	return id, nil
+}
+
+// Replace adds a new node instead of an existing one, and connects consumers of the old node to the new one.
+//
+// Ports maps output ports of the old node to output ports of the new node. If it's nil, ports are preserved.
+// Inputs of the new node are not changed.
+func (g *Graph) Replace(id types.NodeID, n *Node, ports map[int]int) (types.NodeID, error) {
+	if g.Nodes[id] == nil {
+		return 0, fmt.Errorf("node %v not found", id)
+	}
+	refs := g.NodeConsumers(id)
+	for _, ref := range refs {
+		l := g.Nodes[ref.Node].Inputs[ref.Input].(Link)
+		if ports == nil {
+			continue
+		}
+		if _, ok := ports[l.OutPort]; !ok {
+			return 0, fmt.Errorf("node %v: port %d is used by node %v, but not mapped", id, l.OutPort, ref.Node)
+		}
+	}
+	nid := g.Add(n)
+	for _, ref := range refs {
+		in := g.Nodes[ref.Node].Inputs
+		l := in[ref.Input].(Link)
+		port := l.OutPort
+		if ports != nil {
+			port = ports[port]
+		}
+		in[ref.Input] = Link{NodeID: nid, OutPort: port}
+	}
+	delete(g.Nodes, id)
+	return nid, nil
+}
@@ -0,0 +1,67 @@
+package apigraph
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestEdit(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	orig := g.Clone()
+
+	loader := g.FindByClass("CheckpointLoaderSimple")[0]
+	model := Link{NodeID: loader.ID, OutPort: 0}
+	clip := Link{NodeID: loader.ID, OutPort: 1}
+	must.Eq(t, []InputRef{{Node: 3, Input: "model"}}, g.Consumers(model))
+	must.Eq(t, []InputRef{{Node: 6, Input: "clip"}, {Node: 7, Input: "clip"}}, g.Consumers(clip))
+	must.Len(t, 2, g.FindByTitle("CLIP Text Encode (Prompt)"))
+	_, err = g.NodeByTitle("CLIP Text Encode (Prompt)")
+	must.Error(t, err)
+	_, err = g.NodeByTitle("Positive")
+	must.Error(t, err)
+	g.Nodes[6].SetTitle("Positive")
+	pos, err := g.NodeByTitle("Positive")
+	must.NoError(t, err)
+	must.EqOp(t, 6, pos.ID)
+
+	// insert LoRA between the checkpoint loader and the sampler/text encoders
+	lora, err := g.Insert(model, &Node{Class: "LoraLoader", Inputs: map[string]Value{
+		"lora_name": String("lora.safetensors"),
+	}}, "model", 0)
+	must.NoError(t, err)
+	g.Nodes[lora].Inputs["clip"] = clip
+	must.EqOp(t, 2, g.Redirect(clip, Link{NodeID: lora, OutPort: 1}, lora))
+	must.Eq[Value](t, Link{NodeID: lora, OutPort: 0}, g.Nodes[3].Inputs["model"])
+	must.Eq[Value](t, Link{NodeID: lora, OutPort: 1}, g.Nodes[6].Inputs["clip"])
+	must.Eq[Value](t, model, g.Nodes[lora].Inputs["model"])
+
+	// replace the preview with a different node
+	save, err := g.Replace(9, &Node{Class: "PreviewImage", Inputs: g.Nodes[9].Inputs}, nil)
+	must.NoError(t, err)
+	must.Nil(t, g.Nodes[9])
+	must.EqOp(t, "PreviewImage", g.Nodes[save].Class)
+
+	// replace VAE decode, remapping ports
+	_, err = g.Replace(8, &Node{Class: "Other"}, map[int]int{1: 0})
+	must.Error(t, err)
+	dec, err := g.Replace(8, &Node{Class: "Other"}, map[int]int{0: 2})
+	must.NoError(t, err)
+	must.Eq[Value](t, Link{NodeID: dec, OutPort: 2}, g.Nodes[save].Inputs["images"])
+
+	// removing a node disconnects its consumers
+	refs := g.Remove(dec)
+	must.Eq(t, []InputRef{{Node: save, Input: "images"}}, refs)
+	_, ok := g.Nodes[save].Inputs["images"]
+	must.False(t, ok)
+
+	id, err := g.CloneNode(6)
+	must.NoError(t, err)
+	must.Eq(t, g.Nodes[6].Inputs, g.Nodes[id].Inputs)
+
+	// original graph is not modified
+	must.EqOp(t, "SaveImage", orig.Nodes[9].Class)
+	must.Eq[Value](t, model, orig.Nodes[3].Inputs["model"])
+}
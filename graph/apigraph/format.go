@@ -0,0 +1,162 @@
+package apigraph
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+// Canonicalize renumbers nodes in topological order, starting from 1, and updates all links.
+// It returns a mapping from old to new node IDs.
+//
+// Independent nodes are ordered by their structure (see NodeHashes) and titles, rather than current IDs.
+// Thus, graphs that differ only in node IDs are renumbered to the same IDs, which makes diffs between workflow files minimal.
+// Current IDs are only used to order nodes that are otherwise identical.
+func (g *Graph) Canonicalize() (map[types.NodeID]types.NodeID, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	hashes, err := g.NodeHashes()
+	if err != nil {
+		return nil, err
+	}
+	order, err := g.topoSort(func(a, b types.NodeID) int {
+		ha, hb := hashes[a], hashes[b]
+		return cmp.Or(
+			bytes.Compare(ha[:], hb[:]),
+			strings.Compare(g.Nodes[a].Title(), g.Nodes[b].Title()),
+			cmp.Compare(a, b),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[types.NodeID]types.NodeID, len(order))
+	for i, id := range order {
+		ids[id] = types.NodeID(i + 1)
+	}
+	nodes := make(map[types.NodeID]*Node, len(order))
+	for _, id := range order {
+		n := g.Nodes[id]
+		n.ID = ids[id]
+		for name, v := range n.Inputs {
+			if l, ok := v.(Link); ok {
+				n.Inputs[name] = Link{NodeID: ids[l.NodeID], OutPort: l.OutPort}
+			}
+		}
+		nodes[n.ID] = n
+	}
+	g.Nodes = nodes
+	g.LastID = types.NodeID(len(order))
+	return ids, nil
+}
+
+// FormatOptions control how the graph is written by Format.
+type FormatOptions struct {
+	// Indent is the indentation used for each level. Defaults to two spaces.
+	Indent string
+	// StripMeta removes node metadata (such as titles) from the output.
+	StripMeta bool
+}
+
+// Format writes the graph as a pretty-printed JSON in a deterministic way:
+// nodes are sorted by numeric ID, inputs are sorted by name and metadata keys are sorted.
+func Format(w io.Writer, g *Graph, opts *FormatOptions) error {
+	if opts == nil {
+		opts = &FormatOptions{}
+	}
+	ind := opts.Indent
+	if ind == "" {
+		ind = "  "
+	}
+	bw := bufio.NewWriter(w)
+	bw.WriteString("{")
+	for i, id := range slices.Sorted(maps.Keys(g.Nodes)) {
+		n := g.Nodes[id]
+		if i != 0 {
+			bw.WriteString(",")
+		}
+		fmt.Fprintf(bw, "\n%s%q: {\n", ind, id.String())
+		if len(n.Inputs) != 0 {
+			fmt.Fprintf(bw, "%[1]s%[1]s\"inputs\": {", ind)
+			for j, name := range slices.Sorted(maps.Keys(n.Inputs)) {
+				if j != 0 {
+					bw.WriteString(",")
+				}
+				key, err := marshalJSON(name)
+				if err != nil {
+					return err
+				}
+				val, err := marshalJSON(n.Inputs[name])
+				if err != nil {
+					return fmt.Errorf("node %v: input %q: %w", id, name, err)
+				}
+				var buf bytes.Buffer
+				if err = json.Indent(&buf, val, ind+ind+ind, ind); err != nil {
+					return err
+				}
+				fmt.Fprintf(bw, "\n%[1]s%[1]s%[1]s%[2]s: %[3]s", ind, key, buf.Bytes())
+			}
+			fmt.Fprintf(bw, "\n%[1]s%[1]s},\n", ind)
+		}
+		class, err := marshalJSON(n.Class)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(bw, "%[1]s%[1]s\"class_type\": %[2]s", ind, class)
+		if len(n.Meta) != 0 && !opts.StripMeta {
+			var meta any
+			if err := json.Unmarshal(n.Meta, &meta); err != nil {
+				return fmt.Errorf("node %v: cannot decode metadata: %w", id, err)
+			}
+			data, err := marshalJSON(meta)
+			if err != nil {
+				return err
+			}
+			var buf bytes.Buffer
+			if err = json.Indent(&buf, data, ind+ind, ind); err != nil {
+				return err
+			}
+			fmt.Fprintf(bw, ",\n%[1]s%[1]s\"_meta\": %[2]s", ind, buf.Bytes())
+		}
+		fmt.Fprintf(bw, "\n%s}", ind)
+	}
+	if len(g.Nodes) != 0 {
+		bw.WriteString("\n")
+	}
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// FormatFile writes the graph to a file using Format.
+func FormatFile(path string, g *Graph, opts *FormatOptions) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = Format(f, g, opts); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// marshalJSON is similar to json.Marshal, but does not escape HTML characters, which are common in prompts.
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,119 @@
+package apigraph
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dennwc/gocomfy/graph/types"
+	"github.com/shoenig/test/must"
+)
+
+func TestFormat(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	g, err := Unmarshal(data)
+	must.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = Format(&buf, g, nil)
+	must.NoError(t, err)
+	must.EqJSON(t, string(data), buf.String())
+
+	// formatting is stable
+	g2, err := Unmarshal(buf.Bytes())
+	must.NoError(t, err)
+	var buf2 bytes.Buffer
+	err = Format(&buf2, g2, nil)
+	must.NoError(t, err)
+	must.EqOp(t, buf.String(), buf2.String())
+
+	buf.Reset()
+	err = Format(&buf, &Graph{Nodes: map[types.NodeID]*Node{
+		10: {Class: "B", Inputs: map[string]Value{"z": String("<a&b>"), "a": Link{NodeID: 2}}},
+		2:  {Class: "A", Meta: []byte(`{"title":"A","b":1}`)},
+	}}, nil)
+	must.NoError(t, err)
+	must.EqOp(t, `{
+  "2": {
+    "class_type": "A",
+    "_meta": {
+      "b": 1,
+      "title": "A"
+    }
+  },
+  "10": {
+    "inputs": {
+      "a": [
+        "2",
+        0
+      ],
+      "z": "<a&b>"
+    },
+    "class_type": "B"
+  }
+}
+`, buf.String())
+
+	buf.Reset()
+	err = Format(&buf, g, &FormatOptions{StripMeta: true})
+	must.NoError(t, err)
+	must.StrNotContains(t, buf.String(), "_meta")
+}
+
+func TestCanonicalize(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+
+	ids, err := g.Canonicalize()
+	must.NoError(t, err)
+	must.MapLen(t, 7, ids)
+	must.EqOp(t, types.NodeID(7), g.LastID)
+	for id, n := range g.Nodes {
+		must.EqOp(t, id, n.ID)
+		for _, v := range n.Inputs {
+			if l, ok := v.(Link); ok {
+				// inputs always come before the node
+				must.Less(t, id, l.NodeID)
+			}
+		}
+	}
+	must.NoError(t, g.Validate())
+
+	// shuffled IDs are renumbered to the same graph
+	g2 := New()
+	g2.LastID = 50
+	_, err = g2.Import(g)
+	must.NoError(t, err)
+	_, err = g2.Canonicalize()
+	must.NoError(t, err)
+	must.Eq(t, g.Nodes, g2.Nodes)
+}
+
+func TestCanonicalizeRenumbered(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+
+	// reverse the order of node IDs, which changes the order of independent nodes
+	g2 := &Graph{Nodes: make(map[types.NodeID]*Node, len(g.Nodes))}
+	renumber := func(id types.NodeID) types.NodeID { return 100 - id }
+	for id, n := range g.Clone().Nodes {
+		n.ID = renumber(id)
+		for name, v := range n.Inputs {
+			if l, ok := v.(Link); ok {
+				n.Inputs[name] = Link{NodeID: renumber(l.NodeID), OutPort: l.OutPort}
+			}
+		}
+		g2.Nodes[n.ID] = n
+	}
+
+	format := func(g *Graph) string {
+		_, err := g.Canonicalize()
+		must.NoError(t, err)
+		var buf bytes.Buffer
+		must.NoError(t, Format(&buf, g, nil))
+		return buf.String()
+	}
+	must.EqOp(t, format(g), format(g2))
+}
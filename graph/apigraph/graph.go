@@ -63,6 +63,7 @@ type jsonNode struct {
 	Meta   json.RawMessage            `json:"_meta,omitempty"`
 }
 
+// Meta is node metadata stored in the "_meta" field. It is ignored by the server, but preserved by the UI.
 type Meta struct {
 	Title string `json:"title,omitempty"`
 }
@@ -204,3 +205,37 @@ type Node struct {
 	Inputs map[string]Value `json:"inputs,omitempty"`
 	Meta   json.RawMessage  `json:"_meta,omitempty"`
 }
+
+// Title returns the title of the node from its metadata, or an empty string if it's not set.
+func (n *Node) Title() string {
+	if len(n.Meta) == 0 {
+		return ""
+	}
+	var m Meta
+	if err := json.Unmarshal(n.Meta, &m); err != nil {
+		return ""
+	}
+	return m.Title
+}
+
+// SetTitle sets the title of the node in its metadata, preserving other metadata fields.
+// Empty title removes it from the metadata.
+func (n *Node) SetTitle(title string) {
+	var m map[string]json.RawMessage
+	if len(n.Meta) != 0 {
+		_ = json.Unmarshal(n.Meta, &m)
+	}
+	if title == "" {
+		delete(m, "title")
+	} else {
+		if m == nil {
+			m = make(map[string]json.RawMessage)
+		}
+		m["title"], _ = json.Marshal(title)
+	}
+	if len(m) == 0 {
+		n.Meta = nil
+		return
+	}
+	n.Meta, _ = json.Marshal(m)
+}
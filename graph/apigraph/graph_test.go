@@ -29,3 +29,24 @@ func TestJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeTitle(t *testing.T) {
+	n := &Node{Class: "KSampler"}
+	must.EqOp(t, "", n.Title())
+
+	n.SetTitle("Sampler")
+	must.EqOp(t, "Sampler", n.Title())
+	must.EqJSON(t, `{"title":"Sampler"}`, string(n.Meta))
+
+	// other metadata fields are preserved
+	n.Meta = []byte(`{"title":"Sampler","color":"red"}`)
+	n.SetTitle("Refiner")
+	must.EqOp(t, "Refiner", n.Title())
+	must.EqJSON(t, `{"title":"Refiner","color":"red"}`, string(n.Meta))
+
+	n.SetTitle("")
+	must.EqJSON(t, `{"color":"red"}`, string(n.Meta))
+	n.Meta = []byte(`{"title":"Sampler"}`)
+	n.SetTitle("")
+	must.Nil(t, n.Meta)
+}
@@ -0,0 +1,171 @@
+package apigraph
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+// Hash is a canonical hash of a graph or a node.
+type Hash [sha256.Size]byte
+
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+func (h Hash) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+func (h *Hash) UnmarshalText(data []byte) error {
+	if hex.DecodedLen(len(data)) != len(h) {
+		return fmt.Errorf("invalid hash length: %d", len(data))
+	}
+	_, err := hex.Decode(h[:], data)
+	return err
+}
+
+// Hash computes a canonical hash of the graph.
+//
+// The hash only depends on node classes, inputs and links between nodes.
+// It does not depend on node IDs and node metadata, thus graphs that only differ in node numbering have the same hash.
+func (g *Graph) Hash() (Hash, error) {
+	nodes, err := g.NodeHashes()
+	if err != nil {
+		return Hash{}, err
+	}
+	list := make([]Hash, 0, len(nodes))
+	for _, h := range nodes {
+		list = append(list, h)
+	}
+	slices.SortFunc(list, func(a, b Hash) int {
+		return strings.Compare(string(a[:]), string(b[:]))
+	})
+	hw := sha256.New()
+	writeUint(hw, uint64(len(list)))
+	for _, h := range list {
+		hw.Write(h[:])
+	}
+	var out Hash
+	hw.Sum(out[:0])
+	return out, nil
+}
+
+// NodeHashes computes canonical hashes of all nodes in the graph.
+//
+// The hash of the node covers its class, inputs and, recursively, hashes of all nodes it depends on.
+// Thus, two nodes have the same hash if they compute the same value, regardless of their IDs.
+func (g *Graph) NodeHashes() (map[types.NodeID]Hash, error) {
+	hs := &nodeHasher{
+		g:      g,
+		hashes: make(map[types.NodeID]Hash, len(g.Nodes)),
+		active: make(map[types.NodeID]struct{}),
+	}
+	for id := range g.Nodes {
+		if _, err := hs.hash(id); err != nil {
+			return nil, err
+		}
+	}
+	return hs.hashes, nil
+}
+
+type nodeHasher struct {
+	g      *Graph
+	hashes map[types.NodeID]Hash
+	active map[types.NodeID]struct{}
+	// shape is set to only hash the structure of the graph, ignoring scalar input values.
+	shape bool
+}
+
+const (
+	hashNull = byte(iota)
+	hashInt
+	hashFloat
+	hashString
+	hashBool
+	hashLink
+	hashScalar
+)
+
+func (hs *nodeHasher) hash(id types.NodeID) (Hash, error) {
+	if h, ok := hs.hashes[id]; ok {
+		return h, nil
+	}
+	n := hs.g.Nodes[id]
+	if n == nil {
+		return Hash{}, fmt.Errorf("node %v not found", id)
+	}
+	if _, ok := hs.active[id]; ok {
+		return Hash{}, fmt.Errorf("cycle detected at node %v", id)
+	}
+	hs.active[id] = struct{}{}
+	defer delete(hs.active, id)
+
+	hw := sha256.New()
+	writeString(hw, string(n.Class))
+	writeUint(hw, uint64(len(n.Inputs)))
+	names := make([]string, 0, len(n.Inputs))
+	for name := range n.Inputs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		writeString(hw, name)
+		v := n.Inputs[name]
+		if _, ok := v.(Link); hs.shape && !ok && v != nil {
+			hw.Write([]byte{hashScalar})
+			continue
+		}
+		switch v := v.(type) {
+		case nil:
+			hw.Write([]byte{hashNull})
+		case Int:
+			hw.Write([]byte{hashInt})
+			writeUint(hw, uint64(v))
+		case Float:
+			hw.Write([]byte{hashFloat})
+			writeUint(hw, math.Float64bits(float64(v)))
+		case String:
+			hw.Write([]byte{hashString})
+			writeString(hw, string(v))
+		case Bool:
+			b := byte(0)
+			if v {
+				b = 1
+			}
+			hw.Write([]byte{hashBool, b})
+		case Link:
+			sub, err := hs.hash(v.NodeID)
+			if err != nil {
+				return Hash{}, fmt.Errorf("node %v: input %q: %w", id, name, err)
+			}
+			hw.Write([]byte{hashLink})
+			hw.Write(sub[:])
+			writeUint(hw, uint64(v.OutPort))
+		default:
+			return Hash{}, fmt.Errorf("node %v: input %q: unsupported value type: %T", id, name, v)
+		}
+	}
+	var out Hash
+	hw.Sum(out[:0])
+	hs.hashes[id] = out
+	return out, nil
+}
+
+func writeUint(h hash.Hash, v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	h.Write(buf[:])
+}
+
+func writeString(h hash.Hash, s string) {
+	writeUint(h, uint64(len(s)))
+	h.Write([]byte(s))
+}
@@ -0,0 +1,54 @@
+package apigraph
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dennwc/gocomfy/graph/types"
+	"github.com/shoenig/test/must"
+)
+
+func TestHash(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	h1, err := g.Hash()
+	must.NoError(t, err)
+
+	// Renumber nodes and drop metadata.
+	g2 := New()
+	ids := make(map[types.NodeID]types.NodeID)
+	for id := range g.Nodes {
+		ids[id] = id*10 + 1
+	}
+	for id, n := range g.Nodes {
+		n2 := &Node{ID: ids[id], Class: n.Class, Inputs: make(map[string]Value)}
+		for name, v := range n.Inputs {
+			if l, ok := v.(Link); ok {
+				v = Link{NodeID: ids[l.NodeID], OutPort: l.OutPort}
+			}
+			n2.Inputs[name] = v
+		}
+		g2.Nodes[n2.ID] = n2
+	}
+	h2, err := g2.Hash()
+	must.NoError(t, err)
+	must.EqOp(t, h1, h2)
+
+	// Changing the seed changes the hash of the sampler and all downstream nodes.
+	g2.Nodes[ids[3]].Inputs["seed"] = Int(1)
+	h3, err := g2.Hash()
+	must.NoError(t, err)
+	must.NotEqOp(t, h1, h3)
+
+	nh1, err := g.NodeHashes()
+	must.NoError(t, err)
+	nh3, err := g2.NodeHashes()
+	must.NoError(t, err)
+	must.EqOp(t, nh1[4], nh3[ids[4]])
+	must.NotEqOp(t, nh1[9], nh3[ids[9]])
+
+	// Cycles are rejected.
+	g2.Nodes[ids[4]].Inputs["loop"] = Link{NodeID: ids[9]}
+	_, err = g2.Hash()
+	must.ErrorContains(t, err, "cycle")
+}
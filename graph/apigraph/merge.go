@@ -0,0 +1,140 @@
+package apigraph
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+// Import copies all nodes of the source graph into this graph, assigning them new IDs after LastID.
+// Links between imported nodes are remapped accordingly. It returns a mapping from source to new node IDs.
+func (g *Graph) Import(src *Graph) (map[types.NodeID]types.NodeID, error) {
+	ids := make(map[types.NodeID]types.NodeID, len(src.Nodes))
+	next := g.LastID
+	for _, id := range slices.Sorted(maps.Keys(src.Nodes)) {
+		next++
+		ids[id] = next
+	}
+	nodes := make([]*Node, 0, len(src.Nodes))
+	for _, id := range slices.Sorted(maps.Keys(src.Nodes)) {
+		n := src.Nodes[id].Clone()
+		for name, v := range n.Inputs {
+			l, ok := v.(Link)
+			if !ok {
+				continue
+			}
+			nid, ok := ids[l.NodeID]
+			if !ok {
+				return nil, fmt.Errorf("node %v: input %q: node %v not found", id, name, l.NodeID)
+			}
+			n.Inputs[name] = Link{NodeID: nid, OutPort: l.OutPort}
+		}
+		nodes = append(nodes, n)
+	}
+	for _, n := range nodes {
+		g.Add(n)
+	}
+	return ids, nil
+}
+
+const (
+	// FragmentInputPrefix is a title prefix of placeholder nodes that declare fragment inputs.
+	FragmentInputPrefix = "in:"
+	// FragmentOutputPrefix is a title prefix of nodes that declare fragment outputs.
+	FragmentOutputPrefix = "out:"
+)
+
+// Fragment is a reusable part of the graph with named inputs and outputs.
+type Fragment struct {
+	Graph *Graph
+	// Inputs maps input names to node inputs that are connected to the host graph.
+	Inputs map[string][]InputRef
+	// Outputs maps output names to output ports of fragment nodes.
+	Outputs map[string]Link
+}
+
+// NewFragment creates a fragment from the graph, declaring inputs and outputs by node titles.
+//
+// A node titled "in:<name>" is a placeholder for the input: it is removed from the fragment,
+// and all inputs connected to it are exposed as the fragment input with a given name.
+// A node titled "out:<name>" declares its first output port as the fragment output.
+// More inputs and outputs can be declared with DeclareInput and DeclareOutput.
+func NewFragment(g *Graph) (*Fragment, error) {
+	f := &Fragment{
+		Graph:   g.Clone(),
+		Inputs:  make(map[string][]InputRef),
+		Outputs: make(map[string]Link),
+	}
+	for _, id := range slices.Sorted(maps.Keys(f.Graph.Nodes)) {
+		title := f.Graph.Nodes[id].Title()
+		if name, ok := strings.CutPrefix(title, FragmentInputPrefix); ok {
+			refs := f.Graph.Remove(id)
+			if len(refs) == 0 {
+				return nil, fmt.Errorf("fragment input %q is not used", name)
+			}
+			f.Inputs[name] = append(f.Inputs[name], refs...)
+		} else if name, ok := strings.CutPrefix(title, FragmentOutputPrefix); ok {
+			if _, ok := f.Outputs[name]; ok {
+				return nil, fmt.Errorf("duplicate fragment output %q", name)
+			}
+			f.Outputs[name] = Link{NodeID: id}
+		}
+	}
+	return f, nil
+}
+
+// DeclareInput exposes node inputs as a fragment input.
+func (f *Fragment) DeclareInput(name string, refs ...InputRef) error {
+	for _, ref := range refs {
+		if f.Graph.Nodes[ref.Node] == nil {
+			return fmt.Errorf("fragment input %q: node %v not found", name, ref.Node)
+		}
+	}
+	f.Inputs[name] = append(f.Inputs[name], refs...)
+	return nil
+}
+
+// DeclareOutput exposes an output port of a fragment node as a fragment output.
+func (f *Fragment) DeclareOutput(name string, out Link) error {
+	if f.Graph.Nodes[out.NodeID] == nil {
+		return fmt.Errorf("fragment output %q: node %v not found", name, out.NodeID)
+	}
+	f.Outputs[name] = out
+	return nil
+}
+
+// Attach imports the fragment into the graph, connecting fragment inputs to given links in the graph.
+// All fragment inputs must be connected. It returns links to the fragment outputs in this graph.
+func (g *Graph) Attach(f *Fragment, inputs map[string]Link) (map[string]Link, error) {
+	for name := range f.Inputs {
+		l, ok := inputs[name]
+		if !ok {
+			return nil, fmt.Errorf("fragment input %q is not connected", name)
+		}
+		if g.Nodes[l.NodeID] == nil {
+			return nil, fmt.Errorf("fragment input %q: node %v not found", name, l.NodeID)
+		}
+	}
+	for name := range inputs {
+		if _, ok := f.Inputs[name]; !ok {
+			return nil, fmt.Errorf("unknown fragment input %q", name)
+		}
+	}
+	ids, err := g.Import(f.Graph)
+	if err != nil {
+		return nil, err
+	}
+	for name, refs := range f.Inputs {
+		for _, ref := range refs {
+			g.Nodes[ids[ref.Node]].Inputs[ref.Input] = inputs[name]
+		}
+	}
+	outs := make(map[string]Link, len(f.Outputs))
+	for name, l := range f.Outputs {
+		outs[name] = Link{NodeID: ids[l.NodeID], OutPort: l.OutPort}
+	}
+	return outs, nil
+}
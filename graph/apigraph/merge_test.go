@@ -0,0 +1,45 @@
+package apigraph
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestAttach(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	lastID := g.LastID
+
+	up, err := Unmarshal([]byte(`{
+		"1": {"class_type": "PreviewImage", "_meta": {"title": "in:image"}},
+		"2": {"class_type": "UpscaleModelLoader", "inputs": {"model_name": "4x.pth"}},
+		"3": {"class_type": "ImageUpscaleWithModel", "inputs": {"upscale_model": ["2", 0], "image": ["1", 0]}, "_meta": {"title": "out:image"}},
+		"4": {"class_type": "SaveImage", "inputs": {"images": ["3", 0], "filename_prefix": "up"}}
+	}`))
+	must.NoError(t, err)
+	f, err := NewFragment(up)
+	must.NoError(t, err)
+	must.Eq(t, map[string][]InputRef{"image": {{Node: 3, Input: "image"}}}, f.Inputs)
+	must.Eq(t, map[string]Link{"image": {NodeID: 3}}, f.Outputs)
+	must.MapLen(t, 3, f.Graph.Nodes)
+
+	_, err = g.Attach(f, nil)
+	must.Error(t, err)
+
+	outs, err := g.Attach(f, map[string]Link{"image": {NodeID: 8}})
+	must.NoError(t, err)
+	must.MapLen(t, 10, g.Nodes)
+	must.EqOp(t, lastID+3, g.LastID)
+	out := outs["image"]
+	must.EqOp(t, "ImageUpscaleWithModel", g.Nodes[out.NodeID].Class)
+	must.Eq[Value](t, Link{NodeID: 8}, g.Nodes[out.NodeID].Inputs["image"])
+	must.Eq[Value](t, Link{NodeID: lastID + 1}, g.Nodes[out.NodeID].Inputs["upscale_model"])
+
+	// attaching twice creates independent copies
+	outs2, err := g.Attach(f, map[string]Link{"image": out})
+	must.NoError(t, err)
+	must.NotEq(t, out, outs2["image"])
+	must.Eq[Value](t, out, g.Nodes[outs2["image"].NodeID].Inputs["image"])
+}
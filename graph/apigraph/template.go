@@ -0,0 +1,338 @@
+package apigraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+// InputRef is a reference to an input of a node.
+type InputRef struct {
+	Node  types.NodeID
+	Input string
+}
+
+// Selector selects node inputs bound to a template parameter.
+type Selector interface {
+	selectInputs(g *Graph) []InputRef
+}
+
+type selectorFunc func(g *Graph) []InputRef
+
+func (f selectorFunc) selectInputs(g *Graph) []InputRef {
+	return f(g)
+}
+
+// ByID selects an input of the node with a given ID.
+func ByID(id types.NodeID, input string) Selector {
+	return selectorFunc(func(g *Graph) []InputRef {
+		if g.Nodes[id] == nil {
+			return nil
+		}
+		return []InputRef{{Node: id, Input: input}}
+	})
+}
+
+// ByTitle selects an input of all nodes with a given title in the metadata.
+func ByTitle(title string, input string) Selector {
+	return selectorFunc(func(g *Graph) []InputRef {
+		return g.selectNodes(input, func(n *Node) bool {
+			return n.Title() == title
+		})
+	})
+}
+
+// ByClass selects an input of all nodes of a given class.
+func ByClass(class types.NodeClass, input string) Selector {
+	return selectorFunc(func(g *Graph) []InputRef {
+		return g.selectNodes(input, func(n *Node) bool {
+			return n.Class == class
+		})
+	})
+}
+
+func (g *Graph) selectNodes(input string, fnc func(n *Node) bool) []InputRef {
+	var out []InputRef
+	for _, id := range slices.Sorted(maps.Keys(g.Nodes)) {
+		if fnc(g.Nodes[id]) {
+			out = append(out, InputRef{Node: id, Input: input})
+		}
+	}
+	return out
+}
+
+// Param is a named parameter of the template.
+type Param struct {
+	Name string
+	// Type of the parameter value: INT, FLOAT, STRING, BOOLEAN or COMBO.
+	Type types.TypeName
+	// Default value of the parameter, taken from the graph.
+	Default Value
+	// Options for COMBO parameters, if known.
+	Options []string
+	// Min and Max values for numeric parameters, if known.
+	Min, Max *float64
+	// Targets are node inputs bound to the parameter.
+	Targets []InputRef
+}
+
+// Template is a graph with named parameters bound to node inputs.
+type Template struct {
+	g       *Graph
+	classes classes.Classes
+	params  map[string]*Param
+}
+
+// NewTemplate creates a template from the graph.
+//
+// Node classes are used to infer parameter types, defaults and constraints. They can be nil,
+// in which case types are inferred from the current input values.
+func NewTemplate(g *Graph, cls classes.Classes) *Template {
+	return &Template{
+		g:       g.Clone(),
+		classes: cls,
+		params:  make(map[string]*Param),
+	}
+}
+
+// Graph returns the graph of the template. It must not be modified.
+func (t *Template) Graph() *Graph {
+	return t.g
+}
+
+// Params returns all template parameters, sorted by name.
+func (t *Template) Params() []*Param {
+	out := make([]*Param, 0, len(t.params))
+	for _, name := range slices.Sorted(maps.Keys(t.params)) {
+		out = append(out, t.params[name])
+	}
+	return out
+}
+
+// Param returns a parameter by name.
+func (t *Template) Param(name string) *Param {
+	return t.params[name]
+}
+
+// Bind binds a named parameter to node inputs selected by the selector.
+//
+// Binding the same name multiple times adds more targets to the parameter. All targets must have the same type.
+// Each input can only be bound to a single parameter. If any of the selected inputs cannot be bound, the template is not modified.
+func (t *Template) Bind(name string, sel Selector) error {
+	refs := sel.selectInputs(t.g)
+	if len(refs) == 0 {
+		return fmt.Errorf("param %q: no matching nodes", name)
+	}
+	p := &Param{Name: name}
+	if cur := t.params[name]; cur != nil {
+		*p = *cur
+		p.Targets = slices.Clone(cur.Targets)
+	}
+	for _, ref := range refs {
+		if slices.Contains(p.Targets, ref) {
+			continue
+		}
+		if other := t.boundTo(ref); other != nil {
+			return fmt.Errorf("param %q: node %v: input %q is already bound to param %q", name, ref.Node, ref.Input, other.Name)
+		}
+		if err := t.bindInput(p, ref); err != nil {
+			return fmt.Errorf("param %q: node %v: %w", name, ref.Node, err)
+		}
+	}
+	t.params[name] = p
+	return nil
+}
+
+// boundTo returns the parameter bound to the input, if any.
+func (t *Template) boundTo(ref InputRef) *Param {
+	for _, p := range t.params {
+		if slices.Contains(p.Targets, ref) {
+			return p
+		}
+	}
+	return nil
+}
+
+func (t *Template) bindInput(p *Param, ref InputRef) error {
+	n := t.g.Nodes[ref.Node]
+	cur := n.Inputs[ref.Input]
+	if _, ok := cur.(Link); ok {
+		return fmt.Errorf("input %q is a link", ref.Input)
+	}
+	var (
+		typ     types.TypeName
+		options []string
+		lim     inputLimits
+	)
+	if in := t.classInput(n.Class, ref.Input); in != nil {
+		if !in.Type.IsScalar() {
+			return fmt.Errorf("input %q is not a scalar: %s", ref.Input, in.Type)
+		}
+		typ = in.Type
+		for _, o := range in.Select {
+			options = append(options, o.Name)
+		}
+		if len(in.Config) != 0 {
+			_ = json.Unmarshal(in.Config, &lim)
+		}
+		if len(options) == 0 {
+			options = lim.Options
+		}
+		if in.IsSelect || len(options) != 0 {
+			typ = types.ComboType
+		}
+	} else if t.classes != nil && t.classes[n.Class] != nil {
+		return fmt.Errorf("input %q is not defined for class %q", ref.Input, n.Class)
+	} else if cur != nil {
+		typ = valueType(cur)
+	} else {
+		return fmt.Errorf("cannot infer type of input %q", ref.Input)
+	}
+	if p.Type == "" {
+		p.Type, p.Options, p.Default = typ, options, cur
+		p.Min, p.Max = lim.Min, lim.Max
+	} else if p.Type != typ {
+		return fmt.Errorf("input %q type %s doesn't match param type %s", ref.Input, typ, p.Type)
+	}
+	p.Targets = append(p.Targets, ref)
+	return nil
+}
+
+type inputLimits struct {
+	Min     *float64 `json:"min"`
+	Max     *float64 `json:"max"`
+	Options []string `json:"options"`
+}
+
+func (t *Template) classInput(class types.NodeClass, name string) *classes.Input {
+	c := t.classes[class]
+	if c == nil {
+		return nil
+	}
+	for i := range c.Inputs {
+		if c.Inputs[i].Name == name {
+			return &c.Inputs[i]
+		}
+	}
+	return nil
+}
+
+func valueType(v Value) types.TypeName {
+	switch v.(type) {
+	case Int:
+		return types.IntType
+	case Float:
+		return types.FloatType
+	case Bool:
+		return types.BoolType
+	default:
+		return types.StringType
+	}
+}
+
+// Instantiate returns a copy of the graph with parameter values applied.
+//
+// Parameters that are not set keep their default values. Values are validated against parameter types,
+// options and limits. Unknown parameter names are rejected.
+func (t *Template) Instantiate(params map[string]any) (*Graph, error) {
+	g := t.g.Clone()
+	for name, v := range params {
+		p := t.params[name]
+		if p == nil {
+			return nil, fmt.Errorf("unknown param %q", name)
+		}
+		val, err := p.convert(v)
+		if err != nil {
+			return nil, fmt.Errorf("param %q: %w", name, err)
+		}
+		for _, ref := range p.Targets {
+			g.Nodes[ref.Node].Inputs[ref.Input] = val
+		}
+	}
+	return g, nil
+}
+
+// convert checks the parameter value and converts it to a graph value.
+func (p *Param) convert(v any) (Value, error) {
+	if v == nil {
+		return nil, fmt.Errorf("value is not set")
+	}
+	if num, ok := v.(json.Number); ok && (p.Type == types.IntType || p.Type == types.FloatType) {
+		// use the narrowest type, so that integers are not rounded
+		if i, err := num.Int64(); err == nil {
+			v = i
+		} else if f, err := num.Float64(); err == nil {
+			v = f
+		} else {
+			return nil, fmt.Errorf("invalid number: %q", num)
+		}
+	}
+	rv := reflect.ValueOf(v)
+	var val Value
+	switch p.Type {
+	case types.IntType:
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			val = Int(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if rv.Uint() > math.MaxInt64 {
+				return nil, fmt.Errorf("value overflows int64: %v", v)
+			}
+			val = Int(rv.Uint())
+		case reflect.Float32, reflect.Float64:
+			// numbers decoded from JSON are float64
+			f := rv.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return nil, fmt.Errorf("value is not an integer: %v", v)
+			}
+			val = Int(f)
+		}
+	case types.FloatType:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			val = Float(rv.Float())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			val = Float(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			val = Float(rv.Uint())
+		}
+	case types.StringType, types.ComboType:
+		if rv.Kind() == reflect.String {
+			val = String(rv.String())
+		}
+	case types.BoolType:
+		if rv.Kind() == reflect.Bool {
+			val = Bool(rv.Bool())
+		}
+	}
+	if val == nil {
+		return nil, fmt.Errorf("unexpected value type for %s: %T", p.Type, v)
+	}
+	var num float64
+	switch val := val.(type) {
+	case Int:
+		num = float64(val)
+	case Float:
+		num = float64(val)
+	case String:
+		if len(p.Options) != 0 && !slices.Contains(p.Options, string(val)) {
+			return nil, fmt.Errorf("unsupported option: %q", string(val))
+		}
+		return val, nil
+	default:
+		return val, nil
+	}
+	if p.Min != nil && num < *p.Min {
+		return nil, fmt.Errorf("value %v is less than %v", num, *p.Min)
+	}
+	if p.Max != nil && num > *p.Max {
+		return nil, fmt.Errorf("value %v is greater than %v", num, *p.Max)
+	}
+	return val, nil
+}
@@ -0,0 +1,109 @@
+package apigraph
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+func TestTemplate(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	f, err := os.Open(filepath.Join(testData, "object_info.json"))
+	must.NoError(t, err)
+	defer f.Close()
+	cls, err := classes.Decode(f)
+	must.NoError(t, err)
+
+	tmpl := NewTemplate(g, cls)
+	must.NoError(t, tmpl.Bind("model", ByClass("CheckpointLoaderSimple", "ckpt_name")))
+	must.NoError(t, tmpl.Bind("seed", ByTitle("KSampler", "seed")))
+	must.NoError(t, tmpl.Bind("sampler", ByClass("KSampler", "sampler_name")))
+	must.NoError(t, tmpl.Bind("cfg", ByClass("KSampler", "cfg")))
+	must.NoError(t, tmpl.Bind("prompt", ByID(6, "text")))
+	must.NoError(t, tmpl.Bind("prompt", ByID(6, "text")))
+	must.NoError(t, tmpl.Bind("negative", ByID(7, "text")))
+	// inputs cannot be bound to multiple params
+	must.ErrorContains(t, tmpl.Bind("text", ByClass("CLIPTextEncode", "text")), `already bound to param "prompt"`)
+	must.Nil(t, tmpl.Param("text"))
+	// binding is atomic: node 6 is already bound to the param, but node 7 is not
+	must.Error(t, tmpl.Bind("prompt", ByClass("CLIPTextEncode", "text")))
+	must.Len(t, 1, tmpl.Param("prompt").Targets)
+	must.Error(t, tmpl.Bind("clip", ByID(6, "clip")))
+	must.Error(t, tmpl.Bind("missing", ByTitle("Missing", "text")))
+
+	seed := tmpl.Param("seed")
+	must.EqOp(t, types.IntType, seed.Type)
+	must.Eq[Value](t, Int(156680208700286), seed.Default)
+	must.EqOp(t, 0, *seed.Min)
+	must.EqOp(t, types.ComboType, tmpl.Param("sampler").Type)
+	must.SliceContains(t, tmpl.Param("sampler").Options, "euler")
+
+	g2, err := tmpl.Instantiate(map[string]any{
+		"model":  "other.safetensors",
+		"seed":   uint64(42),
+		"prompt": "a cat",
+	})
+	must.NoError(t, err)
+	must.Eq[Value](t, String("other.safetensors"), g2.Nodes[4].Inputs["ckpt_name"])
+	must.Eq[Value](t, Int(42), g2.Nodes[3].Inputs["seed"])
+	must.Eq[Value](t, String("a cat"), g2.Nodes[6].Inputs["text"])
+	must.Eq[Value](t, String("euler"), g2.Nodes[3].Inputs["sampler_name"])
+	// original graph is not modified
+	must.Eq[Value](t, String("beautiful scenery nature glass bottle landscape, , purple galaxy bottle,"), g.Nodes[6].Inputs["text"])
+
+	for _, params := range []map[string]any{
+		{"unknown": 1},
+		{"seed": "1"},
+		{"seed": -1},
+		{"sampler": "unknown"},
+		{"seed": 1.5},
+	} {
+		_, err = tmpl.Instantiate(params)
+		must.Error(t, err)
+	}
+}
+
+func TestTemplateJSONParams(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	f, err := os.Open(filepath.Join(testData, "object_info.json"))
+	must.NoError(t, err)
+	defer f.Close()
+	cls, err := classes.Decode(f)
+	must.NoError(t, err)
+
+	tmpl := NewTemplate(g, cls)
+	must.NoError(t, tmpl.Bind("seed", ByClass("KSampler", "seed")))
+	must.NoError(t, tmpl.Bind("steps", ByClass("KSampler", "steps")))
+	must.NoError(t, tmpl.Bind("cfg", ByClass("KSampler", "cfg")))
+
+	const data = `{"seed": 156680208700287, "steps": 30, "cfg": 7}`
+	var params map[string]any
+	must.NoError(t, json.Unmarshal([]byte(data), &params))
+	g2, err := tmpl.Instantiate(params)
+	must.NoError(t, err)
+	must.Eq[Value](t, Int(156680208700287), g2.Nodes[3].Inputs["seed"])
+	must.Eq[Value](t, Int(30), g2.Nodes[3].Inputs["steps"])
+	must.Eq[Value](t, Float(7), g2.Nodes[3].Inputs["cfg"])
+
+	// large integers are only exact with json.Number
+	dec := json.NewDecoder(strings.NewReader(`{"seed": 1125899906842625, "cfg": 7.5}`))
+	dec.UseNumber()
+	params = nil
+	must.NoError(t, dec.Decode(&params))
+	g2, err = tmpl.Instantiate(params)
+	must.NoError(t, err)
+	must.Eq[Value](t, Int(1125899906842625), g2.Nodes[3].Inputs["seed"])
+	must.Eq[Value](t, Float(7.5), g2.Nodes[3].Inputs["cfg"])
+
+	_, err = tmpl.Instantiate(map[string]any{"steps": json.Number("1.5")})
+	must.Error(t, err)
+}
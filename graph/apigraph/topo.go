@@ -0,0 +1,182 @@
+package apigraph
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+var (
+	// ErrCycle is returned if the graph contains a cycle.
+	ErrCycle = errors.New("graph contains a cycle")
+	// ErrDanglingLink is returned if a node input is linked to a node that doesn't exist.
+	ErrDanglingLink = errors.New("dangling link")
+)
+
+// Validate checks that all links point to existing nodes and the graph has no cycles.
+func (g *Graph) Validate() error {
+	_, err := g.TopoSort()
+	return err
+}
+
+// checkLinks checks that all links point to existing nodes.
+func (g *Graph) checkLinks() error {
+	for _, id := range slices.Sorted(maps.Keys(g.Nodes)) {
+		n := g.Nodes[id]
+		if n.ID != id {
+			return fmt.Errorf("node %v has a wrong ID: %v", id, n.ID)
+		}
+		for _, name := range slices.Sorted(maps.Keys(n.Inputs)) {
+			l, ok := n.Inputs[name].(Link)
+			if ok && g.Nodes[l.NodeID] == nil {
+				return fmt.Errorf("%w: node %v: input %q: node %v not found", ErrDanglingLink, id, name, l.NodeID)
+			}
+		}
+	}
+	return nil
+}
+
+// TopoSort returns node IDs in topological order: each node comes after all nodes it depends on.
+// Independent nodes are ordered by ID, thus the order is deterministic.
+func (g *Graph) TopoSort() ([]types.NodeID, error) {
+	return g.topoSort(cmp.Compare[types.NodeID])
+}
+
+// topoSort is like TopoSort, but independent nodes are ordered by a given function.
+// It must define a total order, so that the result is deterministic.
+func (g *Graph) topoSort(compare func(a, b types.NodeID) int) ([]types.NodeID, error) {
+	if err := g.checkLinks(); err != nil {
+		return nil, err
+	}
+	deps := make(map[types.NodeID]int, len(g.Nodes))
+	users := make(map[types.NodeID][]types.NodeID)
+	for id, n := range g.Nodes {
+		seen := make(map[types.NodeID]struct{})
+		for _, v := range n.Inputs {
+			l, ok := v.(Link)
+			if !ok {
+				continue
+			}
+			if _, ok := seen[l.NodeID]; ok {
+				continue
+			}
+			seen[l.NodeID] = struct{}{}
+			deps[id]++
+			users[l.NodeID] = append(users[l.NodeID], id)
+		}
+	}
+	var ready []types.NodeID
+	for id := range g.Nodes {
+		if deps[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+	slices.SortFunc(ready, compare)
+	out := make([]types.NodeID, 0, len(g.Nodes))
+	for len(ready) != 0 {
+		id := ready[0]
+		ready = ready[1:]
+		out = append(out, id)
+		for _, u := range users[id] {
+			deps[u]--
+			if deps[u] == 0 {
+				i, _ := slices.BinarySearchFunc(ready, u, compare)
+				ready = slices.Insert(ready, i, u)
+			}
+		}
+	}
+	if len(out) != len(g.Nodes) {
+		var cycle []types.NodeID
+		for id := range g.Nodes {
+			if deps[id] != 0 {
+				cycle = append(cycle, id)
+			}
+		}
+		slices.Sort(cycle)
+		return nil, fmt.Errorf("%w: nodes %v", ErrCycle, cycle)
+	}
+	return out, nil
+}
+
+// Leaves returns IDs of nodes which outputs are not used by other nodes, ordered by ID.
+func (g *Graph) Leaves() []types.NodeID {
+	used := make(map[types.NodeID]struct{})
+	for _, n := range g.Nodes {
+		for _, v := range n.Inputs {
+			if l, ok := v.(Link); ok {
+				used[l.NodeID] = struct{}{}
+			}
+		}
+	}
+	var out []types.NodeID
+	for id := range g.Nodes {
+		if _, ok := used[id]; !ok {
+			out = append(out, id)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// OutputNodes returns IDs of output nodes, as defined by the node classes, ordered by ID.
+// Builtin classes are available as apinodes.ClassByName.
+func (g *Graph) OutputNodes(cls classes.Classes) []types.NodeID {
+	var out []types.NodeID
+	for id, n := range g.Nodes {
+		if c := cls[n.Class]; c != nil && c.IsOutput {
+			out = append(out, id)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// Prune removes all nodes that the given output nodes do not depend on. It returns IDs of removed nodes.
+//
+// If no outputs are given, output nodes are detected from the node classes, see OutputNodes.
+func (g *Graph) Prune(cls classes.Classes, outputs ...types.NodeID) ([]types.NodeID, error) {
+	if len(outputs) == 0 {
+		outputs = g.OutputNodes(cls)
+		if len(outputs) == 0 {
+			return nil, errors.New("no output nodes in the graph")
+		}
+	}
+	if err := g.checkLinks(); err != nil {
+		return nil, err
+	}
+	keep := make(map[types.NodeID]struct{}, len(g.Nodes))
+	stack := slices.Clone(outputs)
+	for len(stack) != 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := keep[id]; ok {
+			continue
+		}
+		n := g.Nodes[id]
+		if n == nil {
+			return nil, fmt.Errorf("node %v not found", id)
+		}
+		keep[id] = struct{}{}
+		for _, v := range n.Inputs {
+			if l, ok := v.(Link); ok {
+				stack = append(stack, l.NodeID)
+			}
+		}
+	}
+	var removed []types.NodeID
+	for id := range g.Nodes {
+		if _, ok := keep[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	slices.Sort(removed)
+	for _, id := range removed {
+		delete(g.Nodes, id)
+	}
+	return removed, nil
+}
@@ -0,0 +1,55 @@
+package apigraph
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+func TestTopoSort(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	must.NoError(t, g.Validate())
+
+	order, err := g.TopoSort()
+	must.NoError(t, err)
+	must.Eq(t, []types.NodeID{4, 5, 6, 7, 3, 8, 9}, order)
+	must.Eq(t, []types.NodeID{9}, g.Leaves())
+
+	g2 := g.Clone()
+	g2.Nodes[4].Inputs["loop"] = Link{NodeID: 8}
+	must.ErrorIs(t, g2.Validate(), ErrCycle)
+
+	g2 = g.Clone()
+	g2.Nodes[4].Inputs["missing"] = Link{NodeID: 100}
+	must.ErrorIs(t, g2.Validate(), ErrDanglingLink)
+}
+
+func TestPrune(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	unused := g.Add(&Node{Class: "EmptyLatentImage", Inputs: map[string]Value{"width": Int(64)}})
+	preview := g.Add(&Node{Class: "PreviewImage", Inputs: map[string]Value{"images": Link{NodeID: 8}}})
+
+	g2 := g.Clone()
+	removed, err := g2.Prune(nil, 9)
+	must.NoError(t, err)
+	must.Eq(t, []types.NodeID{unused, preview}, removed)
+
+	_, err = g.Clone().Prune(nil)
+	must.Error(t, err)
+
+	cls := classes.Classes{
+		"SaveImage":    {Name: "SaveImage", IsOutput: true},
+		"PreviewImage": {Name: "PreviewImage", IsOutput: true},
+	}
+	must.Eq(t, []types.NodeID{9, preview}, g.OutputNodes(cls))
+	removed, err = g.Prune(cls)
+	must.NoError(t, err)
+	must.Eq(t, []types.NodeID{unused}, removed)
+	must.MapLen(t, 8, g.Nodes)
+}
@@ -0,0 +1,132 @@
+package apigraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+type vizNode struct {
+	ID     types.NodeID
+	Label  []string
+	Output bool
+}
+
+type vizEdge struct {
+	From, To types.NodeID
+	Label    string
+}
+
+// visualize collects nodes and edges of the graph in a deterministic order.
+func (g *Graph) visualize(cls classes.Classes) ([]vizNode, []vizEdge) {
+	ids := slices.Sorted(maps.Keys(g.Nodes))
+	nodes := make([]vizNode, 0, len(ids))
+	var edges []vizEdge
+	for _, id := range ids {
+		n := g.Nodes[id]
+		c := cls[n.Class]
+		vn := vizNode{ID: id, Label: []string{fmt.Sprintf("#%v %s", id, n.Class)}}
+		if title := n.Title(); title != "" && title != string(n.Class) && (c == nil || title != c.Title) {
+			vn.Label = append(vn.Label, title)
+		}
+		vn.Output = c != nil && c.IsOutput
+		nodes = append(nodes, vn)
+		for _, name := range slices.Sorted(maps.Keys(n.Inputs)) {
+			l, ok := n.Inputs[name].(Link)
+			if !ok {
+				continue
+			}
+			edges = append(edges, vizEdge{
+				From:  l.NodeID,
+				To:    id,
+				Label: outputLabel(cls, g.Nodes[l.NodeID], l.OutPort) + " → " + name,
+			})
+		}
+	}
+	return nodes, edges
+}
+
+// outputLabel returns the name and type of the node output port, if known.
+func outputLabel(cls classes.Classes, n *Node, port int) string {
+	var c *classes.Class
+	if n != nil {
+		c = cls[n.Class]
+	}
+	if c == nil || port < 0 || port >= len(c.Outputs) {
+		return fmt.Sprintf("#%d", port)
+	}
+	out := c.Outputs[port]
+	typ := string(out.Type)
+	if typ == "" {
+		typ = "*"
+	}
+	if out.Name == "" || strings.EqualFold(out.Name, typ) {
+		return typ
+	}
+	return out.Name + ": " + typ
+}
+
+// WriteDOT writes the graph in Graphviz DOT format.
+//
+// Nodes are labeled with their class and title, edges with output names and types, and output nodes are highlighted.
+// If classes are nil, output nodes are not detected and edges are only labeled with port numbers.
+func WriteDOT(w io.Writer, g *Graph, cls classes.Classes) error {
+	nodes, edges := g.visualize(cls)
+	bw := bufio.NewWriter(w)
+	bw.WriteString("digraph workflow {\n")
+	bw.WriteString("\trankdir=LR;\n")
+	bw.WriteString("\tnode [shape=box, style=rounded];\n")
+	for _, n := range nodes {
+		fmt.Fprintf(bw, "\tn%v [label=%s", n.ID, dotQuote(strings.Join(n.Label, "\n")))
+		if n.Output {
+			bw.WriteString(`, style="rounded,filled,bold", fillcolor="#ffe0b2"`)
+		}
+		bw.WriteString("];\n")
+	}
+	for _, e := range edges {
+		fmt.Fprintf(bw, "\tn%v -> n%v [label=%s];\n", e.From, e.To, dotQuote(e.Label))
+	}
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart.
+//
+// Nodes are labeled with their class and title, edges with output names and types, and output nodes are highlighted.
+// If classes are nil, output nodes are not detected and edges are only labeled with port numbers.
+func WriteMermaid(w io.Writer, g *Graph, cls classes.Classes) error {
+	nodes, edges := g.visualize(cls)
+	bw := bufio.NewWriter(w)
+	bw.WriteString("flowchart LR\n")
+	var outputs []string
+	for _, n := range nodes {
+		fmt.Fprintf(bw, "\tn%v[%s]\n", n.ID, mermaidQuote(strings.Join(n.Label, "\n")))
+		if n.Output {
+			outputs = append(outputs, fmt.Sprintf("n%v", n.ID))
+		}
+	}
+	for _, e := range edges {
+		fmt.Fprintf(bw, "\tn%v -->|%s| n%v\n", e.From, mermaidQuote(e.Label), e.To)
+	}
+	if len(outputs) != 0 {
+		bw.WriteString("\tclassDef output fill:#ffe0b2,stroke:#e65100,stroke-width:2px\n")
+		fmt.Fprintf(bw, "\tclass %s output\n", strings.Join(outputs, ","))
+	}
+	return bw.Flush()
+}
+
+func mermaidQuote(s string) string {
+	r := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>")
+	return `"` + r.Replace(s) + `"`
+}
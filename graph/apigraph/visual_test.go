@@ -0,0 +1,50 @@
+package apigraph
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/shoenig/test/must"
+)
+
+func TestVisualize(t *testing.T) {
+	g, err := ReadFile(filepath.Join(testData, "default_api.json"))
+	must.NoError(t, err)
+	f, err := os.Open(filepath.Join(testData, "object_info.json"))
+	must.NoError(t, err)
+	defer f.Close()
+	cls, err := classes.Decode(f)
+	must.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = WriteDOT(&buf, g, cls)
+	must.NoError(t, err)
+	dot := buf.String()
+	must.StrHasPrefix(t, "digraph workflow {\n", dot)
+	must.StrContains(t, dot, "\tn6 [label=\"#6 CLIPTextEncode\"];\n")
+	must.StrContains(t, dot, "\tn9 [label=\"#9 SaveImage\", style=\"rounded,filled,bold\", fillcolor=\"#ffe0b2\"];\n")
+	must.StrContains(t, dot, "\tn4 -> n3 [label=\"MODEL → model\"];\n")
+	must.StrContains(t, dot, "\tn6 -> n3 [label=\"CONDITIONING → positive\"];\n")
+
+	buf.Reset()
+	err = WriteMermaid(&buf, g, cls)
+	must.NoError(t, err)
+	mm := buf.String()
+	must.StrHasPrefix(t, "flowchart LR\n", mm)
+	must.StrContains(t, mm, "\tn8[\"#8 VAEDecode\"]\n")
+	must.StrContains(t, mm, "\tn8 -->|\"IMAGE → images\"| n9\n")
+	must.StrContains(t, mm, "\tclass n9 output\n")
+
+	// custom titles are shown, unknown classes only show port numbers
+	g.Nodes[6].Meta = []byte(`{"title":"Positive \"prompt\""}`)
+	g.Nodes[4].Class = "Unknown"
+	buf.Reset()
+	err = WriteMermaid(&buf, g, cls)
+	must.NoError(t, err)
+	mm = buf.String()
+	must.StrContains(t, mm, "\tn6[\"#6 CLIPTextEncode<br/>Positive #quot;prompt#quot;\"]\n")
+	must.StrContains(t, mm, "\tn4 -->|\"#0 → model\"| n3\n")
+}
@@ -494,6 +494,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"CLIPSetLastLayer": {
 		Name:     "CLIPSetLastLayer",
@@ -758,6 +759,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"ChromaRadianceOptions": {
 		Name:     "ChromaRadianceOptions",
@@ -2388,6 +2390,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"ImageCompositeMasked": {
 		Name:     "ImageCompositeMasked",
@@ -2505,6 +2508,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"ImagePadForOutpaint": {
 		Name:     "ImagePadForOutpaint",
@@ -3976,6 +3980,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"LossGraphNode": {
 		Name:     "LossGraphNode",
@@ -3987,6 +3992,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"LotusConditioning": {
 		Name:     "LotusConditioning",
@@ -4213,6 +4219,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"MaskToImage": {
 		Name:     "MaskToImage",
@@ -4264,6 +4271,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 		Outputs: []classes.Output{
 			{Name: "model_file", Type: "STRING"},
 		},
+		IsOutput: true,
 	},
 	"MeshyImageToModelNode": {
 		Name:     "MeshyImageToModelNode",
@@ -4287,6 +4295,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "meshy_task_id", Type: "MESHY_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"MeshyMultiImageToModelNode": {
 		Name:     "MeshyMultiImageToModelNode",
@@ -4310,6 +4319,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "meshy_task_id", Type: "MESHY_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"MeshyRefineNode": {
 		Name:     "MeshyRefineNode",
@@ -4332,6 +4342,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "meshy_task_id", Type: "MESHY_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"MeshyRigModelNode": {
 		Name:     "MeshyRigModelNode",
@@ -4352,6 +4363,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "rig_task_id", Type: "MESHY_RIGGED_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"MeshyTextToModelNode": {
 		Name:     "MeshyTextToModelNode",
@@ -4375,6 +4387,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "meshy_task_id", Type: "MESHY_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"MeshyTextureNode": {
 		Name:     "MeshyTextureNode",
@@ -4397,6 +4410,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "meshy_task_id", Type: "MODEL_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"MinimaxHailuoVideoNode": {
 		Name:     "MinimaxHailuoVideoNode",
@@ -5437,6 +5451,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"MoonvalleyImg2VideoNode": {
 		Name:     "MoonvalleyImg2VideoNode",
@@ -5944,6 +5959,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"PreviewAny": {
 		Name:     "PreviewAny",
@@ -5952,6 +5968,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 		Inputs: []classes.Input{
 			{Name: "source", Kind: classes.InputRequired, Type: "*", Config: []byte(`{}`)},
 		},
+		IsOutput: true,
 	},
 	"PreviewAudio": {
 		Name:     "PreviewAudio",
@@ -5962,6 +5979,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"PreviewImage": {
 		Name:     "PreviewImage",
@@ -5973,6 +5991,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"PrimitiveBoolean": {
 		Name:     "PrimitiveBoolean",
@@ -7015,6 +7034,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveAnimatedWEBP": {
 		Name:     "SaveAnimatedWEBP",
@@ -7029,6 +7049,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveAudio": {
 		Name:     "SaveAudio",
@@ -7040,6 +7061,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveAudioMP3": {
 		Name:     "SaveAudioMP3",
@@ -7052,6 +7074,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveAudioOpus": {
 		Name:     "SaveAudioOpus",
@@ -7064,6 +7087,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveGLB": {
 		Name:     "SaveGLB",
@@ -7074,6 +7098,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveImage": {
 		Name:     "SaveImage",
@@ -7086,6 +7111,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveImageDataSetToFolder": {
 		Name:     "SaveImageDataSetToFolder",
@@ -7098,6 +7124,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveImageTextDataSetToFolder": {
 		Name:     "SaveImageTextDataSetToFolder",
@@ -7111,6 +7138,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveImageWebsocket": {
 		Name:     "SaveImageWebsocket",
@@ -7119,6 +7147,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 		Inputs: []classes.Input{
 			{Name: "images", Kind: classes.InputRequired, Type: "IMAGE"},
 		},
+		IsOutput: true,
 	},
 	"SaveLatent": {
 		Name:     "SaveLatent",
@@ -7130,6 +7159,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveLoRA": {
 		Name:     "SaveLoRA",
@@ -7142,6 +7172,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveSVGNode": {
 		Name:     "SaveSVGNode",
@@ -7153,6 +7184,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveTrainingDataset": {
 		Name:     "SaveTrainingDataset",
@@ -7166,6 +7198,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveVideo": {
 		Name:     "SaveVideo",
@@ -7180,6 +7213,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"SaveWEBM": {
 		Name:     "SaveWEBM",
@@ -7193,6 +7227,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"ScaleROPE": {
 		Name:     "ScaleROPE",
@@ -8093,6 +8128,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"TripoImageToModelNode": {
 		Name:     "TripoImageToModelNode",
@@ -8122,6 +8158,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "model task_id", Type: "MODEL_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"TripoMultiviewToModelNode": {
 		Name:     "TripoMultiviewToModelNode",
@@ -8153,6 +8190,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "model task_id", Type: "MODEL_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"TripoRefineNode": {
 		Name:     "TripoRefineNode",
@@ -8171,6 +8209,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "model task_id", Type: "MODEL_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"TripoRetargetNode": {
 		Name:     "TripoRetargetNode",
@@ -8189,6 +8228,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "retarget task_id", Type: "RETARGET_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"TripoRigNode": {
 		Name:     "TripoRigNode",
@@ -8206,6 +8246,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "rig task_id", Type: "RIG_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"TripoTextToModelNode": {
 		Name:     "TripoTextToModelNode",
@@ -8235,6 +8276,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "model task_id", Type: "MODEL_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"TripoTextureNode": {
 		Name:     "TripoTextureNode",
@@ -8257,6 +8299,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "model_file", Type: "STRING"},
 			{Name: "model task_id", Type: "MODEL_TASK_ID"},
 		},
+		IsOutput: true,
 	},
 	"TruncateText": {
 		Name:     "TruncateText",
@@ -8477,6 +8520,7 @@ var ClassByName = map[types.NodeClass]*classes.Class{
 			{Name: "prompt", Kind: classes.InputHidden, Type: "PROMPT"},
 			{Name: "extra_pnginfo", Kind: classes.InputHidden, Type: "EXTRA_PNGINFO"},
 		},
+		IsOutput: true,
 	},
 	"VPScheduler": {
 		Name:     "VPScheduler",
@@ -9432,7 +9476,7 @@ type WAN_CAMERA_EMBEDDING Link
 type WEBCAM Link
 
 // APG - Adaptive Projected Guidance
-func APG(gr *Graph, model MODEL, eta, norm_threshold, momentum float64) (_ *Node, out_model MODEL) {
+func APG(gr *Graph, model MODEL, eta, norm_threshold, momentum float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "APG",
 		Inputs: map[string]Value{
@@ -9442,11 +9486,12 @@ func APG(gr *Graph, model MODEL, eta, norm_threshold, momentum float64) (_ *Node
 			"momentum":       Float(momentum),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func AddNoise(gr *Graph, model MODEL, noise NOISE, sigmas SIGMAS, latent_image LATENT) (_ *Node, latent LATENT) {
+func AddNoise(gr *Graph, model MODEL, noise NOISE, sigmas SIGMAS, latent_image LATENT, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "AddNoise",
 		Inputs: map[string]Value{
@@ -9456,12 +9501,13 @@ func AddNoise(gr *Graph, model MODEL, noise NOISE, sigmas SIGMAS, latent_image L
 			"latent_image": Link(latent_image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // AddTextPrefix - Add Text Prefix
-func AddTextPrefix(gr *Graph, texts, prefix string) (_ *Node, out_texts STRING) {
+func AddTextPrefix(gr *Graph, texts, prefix string, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "AddTextPrefix",
 		Inputs: map[string]Value{
@@ -9469,12 +9515,13 @@ func AddTextPrefix(gr *Graph, texts, prefix string) (_ *Node, out_texts STRING)
 			"prefix": String(prefix),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // AddTextSuffix - Add Text Suffix
-func AddTextSuffix(gr *Graph, texts, suffix string) (_ *Node, out_texts STRING) {
+func AddTextSuffix(gr *Graph, texts, suffix string, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "AddTextSuffix",
 		Inputs: map[string]Value{
@@ -9482,12 +9529,13 @@ func AddTextSuffix(gr *Graph, texts, suffix string) (_ *Node, out_texts STRING)
 			"suffix": String(suffix),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // AdjustBrightness - Adjust Brightness
-func AdjustBrightness(gr *Graph, images IMAGE, factor float64) (_ *Node, out_images IMAGE) {
+func AdjustBrightness(gr *Graph, images IMAGE, factor float64, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "AdjustBrightness",
 		Inputs: map[string]Value{
@@ -9495,12 +9543,13 @@ func AdjustBrightness(gr *Graph, images IMAGE, factor float64) (_ *Node, out_ima
 			"factor": Float(factor),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // AdjustContrast - Adjust Contrast
-func AdjustContrast(gr *Graph, images IMAGE, factor float64) (_ *Node, out_images IMAGE) {
+func AdjustContrast(gr *Graph, images IMAGE, factor float64, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "AdjustContrast",
 		Inputs: map[string]Value{
@@ -9508,11 +9557,12 @@ func AdjustContrast(gr *Graph, images IMAGE, factor float64) (_ *Node, out_image
 			"factor": Float(factor),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func AlignYourStepsScheduler(gr *Graph, model_type string, steps int, denoise float64) (_ *Node, sigmas SIGMAS) {
+func AlignYourStepsScheduler(gr *Graph, model_type string, steps int, denoise float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "AlignYourStepsScheduler",
 		Inputs: map[string]Value{
@@ -9521,12 +9571,13 @@ func AlignYourStepsScheduler(gr *Graph, model_type string, steps int, denoise fl
 			"denoise":    Float(denoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // AudioAdjustVolume - Audio Adjust Volume
-func AudioAdjustVolume(gr *Graph, audio AUDIO, volume int) (_ *Node, out_audio AUDIO) {
+func AudioAdjustVolume(gr *Graph, audio AUDIO, volume int, opts ...NodeOption) (_ *Node, out_audio AUDIO) {
 	nd := &Node{
 		Class: "AudioAdjustVolume",
 		Inputs: map[string]Value{
@@ -9534,12 +9585,13 @@ func AudioAdjustVolume(gr *Graph, audio AUDIO, volume int) (_ *Node, out_audio A
 			"volume": Int(volume),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // AudioConcat - Audio Concat
-func AudioConcat(gr *Graph, audio1 AUDIO, audio2 AUDIO, direction string) (_ *Node, audio AUDIO) {
+func AudioConcat(gr *Graph, audio1 AUDIO, audio2 AUDIO, direction string, opts ...NodeOption) (_ *Node, audio AUDIO) {
 	nd := &Node{
 		Class: "AudioConcat",
 		Inputs: map[string]Value{
@@ -9548,11 +9600,12 @@ func AudioConcat(gr *Graph, audio1 AUDIO, audio2 AUDIO, direction string) (_ *No
 			"direction": String(direction),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
-func AudioEncoderEncode(gr *Graph, audio_encoder AUDIO_ENCODER, audio AUDIO) (_ *Node, audio_encoder_output AUDIO_ENCODER_OUTPUT) {
+func AudioEncoderEncode(gr *Graph, audio_encoder AUDIO_ENCODER, audio AUDIO, opts ...NodeOption) (_ *Node, audio_encoder_output AUDIO_ENCODER_OUTPUT) {
 	nd := &Node{
 		Class: "AudioEncoderEncode",
 		Inputs: map[string]Value{
@@ -9560,23 +9613,25 @@ func AudioEncoderEncode(gr *Graph, audio_encoder AUDIO_ENCODER, audio AUDIO) (_
 			"audio":         Link(audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO_ENCODER_OUTPUT{NodeID: id, OutPort: 0}
 }
 
-func AudioEncoderLoader(gr *Graph, audio_encoder_name string) (_ *Node, audio_encoder AUDIO_ENCODER) {
+func AudioEncoderLoader(gr *Graph, audio_encoder_name string, opts ...NodeOption) (_ *Node, audio_encoder AUDIO_ENCODER) {
 	nd := &Node{
 		Class: "AudioEncoderLoader",
 		Inputs: map[string]Value{
 			"audio_encoder_name": String(audio_encoder_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO_ENCODER{NodeID: id, OutPort: 0}
 }
 
 // AudioMerge - Audio Merge
-func AudioMerge(gr *Graph, audio1 AUDIO, audio2 AUDIO, merge_method string) (_ *Node, audio AUDIO) {
+func AudioMerge(gr *Graph, audio1 AUDIO, audio2 AUDIO, merge_method string, opts ...NodeOption) (_ *Node, audio AUDIO) {
 	nd := &Node{
 		Class: "AudioMerge",
 		Inputs: map[string]Value{
@@ -9585,11 +9640,12 @@ func AudioMerge(gr *Graph, audio1 AUDIO, audio2 AUDIO, merge_method string) (_ *
 			"merge_method": String(merge_method),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
-func BasicGuider(gr *Graph, model MODEL, conditioning CONDITIONING) (_ *Node, guider GUIDER) {
+func BasicGuider(gr *Graph, model MODEL, conditioning CONDITIONING, opts ...NodeOption) (_ *Node, guider GUIDER) {
 	nd := &Node{
 		Class: "BasicGuider",
 		Inputs: map[string]Value{
@@ -9597,11 +9653,12 @@ func BasicGuider(gr *Graph, model MODEL, conditioning CONDITIONING) (_ *Node, gu
 			"conditioning": Link(conditioning),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, GUIDER{NodeID: id, OutPort: 0}
 }
 
-func BasicScheduler(gr *Graph, model MODEL, scheduler string, steps int, denoise float64) (_ *Node, sigmas SIGMAS) {
+func BasicScheduler(gr *Graph, model MODEL, scheduler string, steps int, denoise float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "BasicScheduler",
 		Inputs: map[string]Value{
@@ -9611,47 +9668,51 @@ func BasicScheduler(gr *Graph, model MODEL, scheduler string, steps int, denoise
 			"denoise":   Float(denoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // BatchImagesNode - Batch Images
-func BatchImagesNode(gr *Graph, images COMFY_AUTOGROW_V3) (_ *Node, image IMAGE) {
+func BatchImagesNode(gr *Graph, images COMFY_AUTOGROW_V3, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "BatchImagesNode",
 		Inputs: map[string]Value{
 			"images": Link(images),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // BatchLatentsNode - Batch Latents
-func BatchLatentsNode(gr *Graph, latents COMFY_AUTOGROW_V3) (_ *Node, latent LATENT) {
+func BatchLatentsNode(gr *Graph, latents COMFY_AUTOGROW_V3, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "BatchLatentsNode",
 		Inputs: map[string]Value{
 			"latents": Link(latents),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // BatchMasksNode - Batch Masks
-func BatchMasksNode(gr *Graph, masks COMFY_AUTOGROW_V3) (_ *Node, mask MASK) {
+func BatchMasksNode(gr *Graph, masks COMFY_AUTOGROW_V3, opts ...NodeOption) (_ *Node, mask MASK) {
 	nd := &Node{
 		Class: "BatchMasksNode",
 		Inputs: map[string]Value{
 			"masks": Link(masks),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
-func BetaSamplingScheduler(gr *Graph, model MODEL, steps int, alpha, beta float64) (_ *Node, sigmas SIGMAS) {
+func BetaSamplingScheduler(gr *Graph, model MODEL, steps int, alpha, beta float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "BetaSamplingScheduler",
 		Inputs: map[string]Value{
@@ -9661,12 +9722,13 @@ func BetaSamplingScheduler(gr *Graph, model MODEL, steps int, alpha, beta float6
 			"beta":  Float(beta),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // ByteDanceFirstLastFrameNode - ByteDance First-Last-Frame to Video
-func ByteDanceFirstLastFrameNode(gr *Graph, first_frame IMAGE, last_frame IMAGE, model string, prompt string, resolution, aspect_ratio string, duration, seed int, camera_fixed, watermark bool) (_ *Node, video VIDEO) {
+func ByteDanceFirstLastFrameNode(gr *Graph, first_frame IMAGE, last_frame IMAGE, model string, prompt string, resolution, aspect_ratio string, duration, seed int, camera_fixed, watermark bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ByteDanceFirstLastFrameNode",
 		Inputs: map[string]Value{
@@ -9679,12 +9741,13 @@ func ByteDanceFirstLastFrameNode(gr *Graph, first_frame IMAGE, last_frame IMAGE,
 			"duration":     Int(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // ByteDanceImageEditNode - ByteDance Image Edit
-func ByteDanceImageEditNode(gr *Graph, image IMAGE, model string, prompt string, seed int, guidance_scale float64, watermark bool) (_ *Node, out_image IMAGE) {
+func ByteDanceImageEditNode(gr *Graph, image IMAGE, model string, prompt string, seed int, guidance_scale float64, watermark bool, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ByteDanceImageEditNode",
 		Inputs: map[string]Value{
@@ -9693,12 +9756,13 @@ func ByteDanceImageEditNode(gr *Graph, image IMAGE, model string, prompt string,
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ByteDanceImageNode - ByteDance Image
-func ByteDanceImageNode(gr *Graph, model string, prompt string, size_preset string, width, height, seed int, guidance_scale float64, watermark bool) (_ *Node, image IMAGE) {
+func ByteDanceImageNode(gr *Graph, model string, prompt string, size_preset string, width, height, seed int, guidance_scale float64, watermark bool, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "ByteDanceImageNode",
 		Inputs: map[string]Value{
@@ -9709,12 +9773,13 @@ func ByteDanceImageNode(gr *Graph, model string, prompt string, size_preset stri
 			"height":      Int(height),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ByteDanceImageReferenceNode - ByteDance Reference Images to Video
-func ByteDanceImageReferenceNode(gr *Graph, images IMAGE, model string, prompt string, resolution, aspect_ratio string, duration, seed int, watermark bool) (_ *Node, video VIDEO) {
+func ByteDanceImageReferenceNode(gr *Graph, images IMAGE, model string, prompt string, resolution, aspect_ratio string, duration, seed int, watermark bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ByteDanceImageReferenceNode",
 		Inputs: map[string]Value{
@@ -9726,12 +9791,13 @@ func ByteDanceImageReferenceNode(gr *Graph, images IMAGE, model string, prompt s
 			"duration":     Int(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // ByteDanceImageToVideoNode - ByteDance Image to Video
-func ByteDanceImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string, resolution, aspect_ratio string, duration, seed int, camera_fixed, watermark bool) (_ *Node, video VIDEO) {
+func ByteDanceImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string, resolution, aspect_ratio string, duration, seed int, camera_fixed, watermark bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ByteDanceImageToVideoNode",
 		Inputs: map[string]Value{
@@ -9743,12 +9809,13 @@ func ByteDanceImageToVideoNode(gr *Graph, image IMAGE, model string, prompt stri
 			"duration":     Int(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // ByteDanceSeedreamNode - ByteDance Seedream 4.5
-func ByteDanceSeedreamNode(gr *Graph, image IMAGE, model string, prompt string, size_preset string, width, height int, sequential_image_generation string, max_images, seed int, watermark, fail_on_partial bool) (_ *Node, out_image IMAGE) {
+func ByteDanceSeedreamNode(gr *Graph, image IMAGE, model string, prompt string, size_preset string, width, height int, sequential_image_generation string, max_images, seed int, watermark, fail_on_partial bool, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ByteDanceSeedreamNode",
 		Inputs: map[string]Value{
@@ -9757,12 +9824,13 @@ func ByteDanceSeedreamNode(gr *Graph, image IMAGE, model string, prompt string,
 			"size_preset": String(size_preset),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ByteDanceTextToVideoNode - ByteDance Text to Video
-func ByteDanceTextToVideoNode(gr *Graph, model string, prompt string, resolution, aspect_ratio string, duration, seed int, camera_fixed, watermark bool) (_ *Node, video VIDEO) {
+func ByteDanceTextToVideoNode(gr *Graph, model string, prompt string, resolution, aspect_ratio string, duration, seed int, camera_fixed, watermark bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ByteDanceTextToVideoNode",
 		Inputs: map[string]Value{
@@ -9773,11 +9841,12 @@ func ByteDanceTextToVideoNode(gr *Graph, model string, prompt string, resolution
 			"duration":     Int(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func CFGGuider(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, cfg float64) (_ *Node, guider GUIDER) {
+func CFGGuider(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, cfg float64, opts ...NodeOption) (_ *Node, guider GUIDER) {
 	nd := &Node{
 		Class: "CFGGuider",
 		Inputs: map[string]Value{
@@ -9787,11 +9856,12 @@ func CFGGuider(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITION
 			"cfg":      Float(cfg),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, GUIDER{NodeID: id, OutPort: 0}
 }
 
-func CFGNorm(gr *Graph, model MODEL, strength float64) (_ *Node, patched_model MODEL) {
+func CFGNorm(gr *Graph, model MODEL, strength float64, opts ...NodeOption) (_ *Node, patched_model MODEL) {
 	nd := &Node{
 		Class: "CFGNorm",
 		Inputs: map[string]Value{
@@ -9799,22 +9869,24 @@ func CFGNorm(gr *Graph, model MODEL, strength float64) (_ *Node, patched_model M
 			"strength": Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func CFGZeroStar(gr *Graph, model MODEL) (_ *Node, patched_model MODEL) {
+func CFGZeroStar(gr *Graph, model MODEL, opts ...NodeOption) (_ *Node, patched_model MODEL) {
 	nd := &Node{
 		Class: "CFGZeroStar",
 		Inputs: map[string]Value{
 			"model": Link(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func CLIPAttentionMultiply(gr *Graph, clip CLIP, q, k, v, out float64) (_ *Node, out_clip CLIP) {
+func CLIPAttentionMultiply(gr *Graph, clip CLIP, q, k, v, out float64, opts ...NodeOption) (_ *Node, out_clip CLIP) {
 	nd := &Node{
 		Class: "CLIPAttentionMultiply",
 		Inputs: map[string]Value{
@@ -9825,12 +9897,13 @@ func CLIPAttentionMultiply(gr *Graph, clip CLIP, q, k, v, out float64) (_ *Node,
 			"out":  Float(out),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
 // CLIPLoader - Load CLIP
-func CLIPLoader(gr *Graph, clip_name, typ, device string) (_ *Node, clip CLIP) {
+func CLIPLoader(gr *Graph, clip_name, typ, device string, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "CLIPLoader",
 		Inputs: map[string]Value{
@@ -9838,11 +9911,12 @@ func CLIPLoader(gr *Graph, clip_name, typ, device string) (_ *Node, clip CLIP) {
 			"type":      String(typ),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func CLIPMergeAdd(gr *Graph, clip1 CLIP, clip2 CLIP) (_ *Node, clip CLIP) {
+func CLIPMergeAdd(gr *Graph, clip1 CLIP, clip2 CLIP, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "CLIPMergeAdd",
 		Inputs: map[string]Value{
@@ -9850,11 +9924,12 @@ func CLIPMergeAdd(gr *Graph, clip1 CLIP, clip2 CLIP) (_ *Node, clip CLIP) {
 			"clip2": Link(clip2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func CLIPMergeSimple(gr *Graph, clip1 CLIP, clip2 CLIP, ratio float64) (_ *Node, clip CLIP) {
+func CLIPMergeSimple(gr *Graph, clip1 CLIP, clip2 CLIP, ratio float64, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "CLIPMergeSimple",
 		Inputs: map[string]Value{
@@ -9863,11 +9938,12 @@ func CLIPMergeSimple(gr *Graph, clip1 CLIP, clip2 CLIP, ratio float64) (_ *Node,
 			"ratio": Float(ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func CLIPMergeSubtract(gr *Graph, clip1 CLIP, clip2 CLIP, multiplier float64) (_ *Node, clip CLIP) {
+func CLIPMergeSubtract(gr *Graph, clip1 CLIP, clip2 CLIP, multiplier float64, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "CLIPMergeSubtract",
 		Inputs: map[string]Value{
@@ -9876,11 +9952,12 @@ func CLIPMergeSubtract(gr *Graph, clip1 CLIP, clip2 CLIP, multiplier float64) (_
 			"multiplier": Float(multiplier),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func CLIPSave(gr *Graph, clip CLIP, filename_prefix string) (_ *Node) {
+func CLIPSave(gr *Graph, clip CLIP, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "CLIPSave",
 		Inputs: map[string]Value{
@@ -9888,12 +9965,13 @@ func CLIPSave(gr *Graph, clip CLIP, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // CLIPSetLastLayer - CLIP Set Last Layer
-func CLIPSetLastLayer(gr *Graph, clip CLIP, stop_at_clip_layer int) (_ *Node, out_clip CLIP) {
+func CLIPSetLastLayer(gr *Graph, clip CLIP, stop_at_clip_layer int, opts ...NodeOption) (_ *Node, out_clip CLIP) {
 	nd := &Node{
 		Class: "CLIPSetLastLayer",
 		Inputs: map[string]Value{
@@ -9901,12 +9979,13 @@ func CLIPSetLastLayer(gr *Graph, clip CLIP, stop_at_clip_layer int) (_ *Node, ou
 			"stop_at_clip_layer": Int(stop_at_clip_layer),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
 // CLIPTextEncode - CLIP Text Encode (Prompt)
-func CLIPTextEncode(gr *Graph, clip CLIP, text string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncode(gr *Graph, clip CLIP, text string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncode",
 		Inputs: map[string]Value{
@@ -9914,11 +9993,12 @@ func CLIPTextEncode(gr *Graph, clip CLIP, text string) (_ *Node, conditioning CO
 			"clip": Link(clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeControlnet(gr *Graph, clip CLIP, conditioning CONDITIONING, text string) (_ *Node, out_conditioning CONDITIONING) {
+func CLIPTextEncodeControlnet(gr *Graph, clip CLIP, conditioning CONDITIONING, text string, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeControlnet",
 		Inputs: map[string]Value{
@@ -9927,11 +10007,12 @@ func CLIPTextEncodeControlnet(gr *Graph, clip CLIP, conditioning CONDITIONING, t
 			"text":         String(text),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeFlux(gr *Graph, clip CLIP, clip_l, t5xxl string, guidance float64) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeFlux(gr *Graph, clip CLIP, clip_l, t5xxl string, guidance float64, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeFlux",
 		Inputs: map[string]Value{
@@ -9941,11 +10022,12 @@ func CLIPTextEncodeFlux(gr *Graph, clip CLIP, clip_l, t5xxl string, guidance flo
 			"guidance": Float(guidance),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeHiDream(gr *Graph, clip CLIP, clip_l, clip_g, t5xxl, llama string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeHiDream(gr *Graph, clip CLIP, clip_l, clip_g, t5xxl, llama string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeHiDream",
 		Inputs: map[string]Value{
@@ -9956,11 +10038,12 @@ func CLIPTextEncodeHiDream(gr *Graph, clip CLIP, clip_l, clip_g, t5xxl, llama st
 			"llama":  String(llama),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeHunyuanDiT(gr *Graph, clip CLIP, bert, mt5xl string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeHunyuanDiT(gr *Graph, clip CLIP, bert, mt5xl string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeHunyuanDiT",
 		Inputs: map[string]Value{
@@ -9969,11 +10052,12 @@ func CLIPTextEncodeHunyuanDiT(gr *Graph, clip CLIP, bert, mt5xl string) (_ *Node
 			"mt5xl": String(mt5xl),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeKandinsky5(gr *Graph, clip CLIP, clip_l, qwen25_7b string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeKandinsky5(gr *Graph, clip CLIP, clip_l, qwen25_7b string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeKandinsky5",
 		Inputs: map[string]Value{
@@ -9982,12 +10066,13 @@ func CLIPTextEncodeKandinsky5(gr *Graph, clip CLIP, clip_l, qwen25_7b string) (_
 			"qwen25_7b": String(qwen25_7b),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // CLIPTextEncodeLumina2 - CLIP Text Encode for Lumina2
-func CLIPTextEncodeLumina2(gr *Graph, clip CLIP, system_prompt string, user_prompt string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeLumina2(gr *Graph, clip CLIP, system_prompt string, user_prompt string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeLumina2",
 		Inputs: map[string]Value{
@@ -9996,11 +10081,12 @@ func CLIPTextEncodeLumina2(gr *Graph, clip CLIP, system_prompt string, user_prom
 			"clip":          Link(clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodePixArtAlpha(gr *Graph, clip CLIP, width, height int, text string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodePixArtAlpha(gr *Graph, clip CLIP, width, height int, text string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodePixArtAlpha",
 		Inputs: map[string]Value{
@@ -10010,11 +10096,12 @@ func CLIPTextEncodePixArtAlpha(gr *Graph, clip CLIP, width, height int, text str
 			"clip":   Link(clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeSD3(gr *Graph, clip CLIP, clip_l, clip_g, t5xxl string, empty_padding string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeSD3(gr *Graph, clip CLIP, clip_l, clip_g, t5xxl string, empty_padding string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeSD3",
 		Inputs: map[string]Value{
@@ -10025,11 +10112,12 @@ func CLIPTextEncodeSD3(gr *Graph, clip CLIP, clip_l, clip_g, t5xxl string, empty
 			"empty_padding": String(empty_padding),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeSDXL(gr *Graph, clip CLIP, width, height, crop_w, crop_h, target_width, target_height int, text_g, text_l string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeSDXL(gr *Graph, clip CLIP, width, height, crop_w, crop_h, target_width, target_height int, text_g, text_l string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeSDXL",
 		Inputs: map[string]Value{
@@ -10044,11 +10132,12 @@ func CLIPTextEncodeSDXL(gr *Graph, clip CLIP, width, height, crop_w, crop_h, tar
 			"text_l":        String(text_l),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func CLIPTextEncodeSDXLRefiner(gr *Graph, clip CLIP, ascore float64, width, height int, text string) (_ *Node, conditioning CONDITIONING) {
+func CLIPTextEncodeSDXLRefiner(gr *Graph, clip CLIP, ascore float64, width, height int, text string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "CLIPTextEncodeSDXLRefiner",
 		Inputs: map[string]Value{
@@ -10059,12 +10148,13 @@ func CLIPTextEncodeSDXLRefiner(gr *Graph, clip CLIP, ascore float64, width, heig
 			"clip":   Link(clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // CLIPVisionEncode - CLIP Vision Encode
-func CLIPVisionEncode(gr *Graph, clip_vision CLIP_VISION, image IMAGE, crop string) (_ *Node, clip_vision_output CLIP_VISION_OUTPUT) {
+func CLIPVisionEncode(gr *Graph, clip_vision CLIP_VISION, image IMAGE, crop string, opts ...NodeOption) (_ *Node, clip_vision_output CLIP_VISION_OUTPUT) {
 	nd := &Node{
 		Class: "CLIPVisionEncode",
 		Inputs: map[string]Value{
@@ -10073,23 +10163,25 @@ func CLIPVisionEncode(gr *Graph, clip_vision CLIP_VISION, image IMAGE, crop stri
 			"crop":        String(crop),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP_VISION_OUTPUT{NodeID: id, OutPort: 0}
 }
 
 // CLIPVisionLoader - Load CLIP Vision
-func CLIPVisionLoader(gr *Graph, clip_name string) (_ *Node, clip_vision CLIP_VISION) {
+func CLIPVisionLoader(gr *Graph, clip_name string, opts ...NodeOption) (_ *Node, clip_vision CLIP_VISION) {
 	nd := &Node{
 		Class: "CLIPVisionLoader",
 		Inputs: map[string]Value{
 			"clip_name": String(clip_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP_VISION{NodeID: id, OutPort: 0}
 }
 
-func Canny(gr *Graph, image IMAGE, low_threshold, high_threshold float64) (_ *Node, out_image IMAGE) {
+func Canny(gr *Graph, image IMAGE, low_threshold, high_threshold float64, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "Canny",
 		Inputs: map[string]Value{
@@ -10098,12 +10190,13 @@ func Canny(gr *Graph, image IMAGE, low_threshold, high_threshold float64) (_ *No
 			"high_threshold": Float(high_threshold),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // CaseConverter - Case Converter
-func CaseConverter(gr *Graph, str string, mode string) (_ *Node, out_str STRING) {
+func CaseConverter(gr *Graph, str string, mode string, opts ...NodeOption) (_ *Node, out_str STRING) {
 	nd := &Node{
 		Class: "CaseConverter",
 		Inputs: map[string]Value{
@@ -10111,12 +10204,13 @@ func CaseConverter(gr *Graph, str string, mode string) (_ *Node, out_str STRING)
 			"mode":   String(mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // CenterCropImages - Center Crop Images
-func CenterCropImages(gr *Graph, images IMAGE, width, height int) (_ *Node, out_images IMAGE) {
+func CenterCropImages(gr *Graph, images IMAGE, width, height int, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "CenterCropImages",
 		Inputs: map[string]Value{
@@ -10125,12 +10219,13 @@ func CenterCropImages(gr *Graph, images IMAGE, width, height int) (_ *Node, out_
 			"height": Int(height),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // CheckpointLoader - Load Checkpoint With Config (DEPRECATED)
-func CheckpointLoader(gr *Graph, config_name, ckpt_name string) (_ *Node, model MODEL, clip CLIP, vae VAE) {
+func CheckpointLoader(gr *Graph, config_name, ckpt_name string, opts ...NodeOption) (_ *Node, model MODEL, clip CLIP, vae VAE) {
 	nd := &Node{
 		Class: "CheckpointLoader",
 		Inputs: map[string]Value{
@@ -10138,24 +10233,26 @@ func CheckpointLoader(gr *Graph, config_name, ckpt_name string) (_ *Node, model
 			"ckpt_name":   String(ckpt_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}, CLIP{NodeID: id, OutPort: 1}, VAE{NodeID: id, OutPort: 2}
 }
 
 // CheckpointLoaderSimple - Load Checkpoint
-func CheckpointLoaderSimple(gr *Graph, ckpt_name string) (_ *Node, model MODEL, clip CLIP, vae VAE) {
+func CheckpointLoaderSimple(gr *Graph, ckpt_name string, opts ...NodeOption) (_ *Node, model MODEL, clip CLIP, vae VAE) {
 	nd := &Node{
 		Class: "CheckpointLoaderSimple",
 		Inputs: map[string]Value{
 			"ckpt_name": String(ckpt_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}, CLIP{NodeID: id, OutPort: 1}, VAE{NodeID: id, OutPort: 2}
 }
 
 // CheckpointSave - Save Checkpoint
-func CheckpointSave(gr *Graph, model MODEL, clip CLIP, vae VAE, filename_prefix string) (_ *Node) {
+func CheckpointSave(gr *Graph, model MODEL, clip CLIP, vae VAE, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "CheckpointSave",
 		Inputs: map[string]Value{
@@ -10165,11 +10262,12 @@ func CheckpointSave(gr *Graph, model MODEL, clip CLIP, vae VAE, filename_prefix
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
-func ChromaRadianceOptions(gr *Graph, model MODEL, preserve_wrapper bool, start_sigma, end_sigma float64, nerf_tile_size int) (_ *Node, out_model MODEL) {
+func ChromaRadianceOptions(gr *Graph, model MODEL, preserve_wrapper bool, start_sigma, end_sigma float64, nerf_tile_size int, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ChromaRadianceOptions",
 		Inputs: map[string]Value{
@@ -10180,42 +10278,46 @@ func ChromaRadianceOptions(gr *Graph, model MODEL, preserve_wrapper bool, start_
 			"nerf_tile_size":   Int(nerf_tile_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // CombineHooks2 - Combine Hooks [2]
-func CombineHooks2(gr *Graph, hooks_a HOOKS, hooks_b HOOKS) (_ *Node, hooks HOOKS) {
+func CombineHooks2(gr *Graph, hooks_a HOOKS, hooks_b HOOKS, opts ...NodeOption) (_ *Node, hooks HOOKS) {
 	nd := &Node{
 		Class:  "CombineHooks2",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // CombineHooks4 - Combine Hooks [4]
-func CombineHooks4(gr *Graph, hooks_a HOOKS, hooks_b HOOKS, hooks_c HOOKS, hooks_d HOOKS) (_ *Node, hooks HOOKS) {
+func CombineHooks4(gr *Graph, hooks_a HOOKS, hooks_b HOOKS, hooks_c HOOKS, hooks_d HOOKS, opts ...NodeOption) (_ *Node, hooks HOOKS) {
 	nd := &Node{
 		Class:  "CombineHooks4",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // CombineHooks8 - Combine Hooks [8]
-func CombineHooks8(gr *Graph, hooks_a HOOKS, hooks_b HOOKS, hooks_c HOOKS, hooks_d HOOKS, hooks_e HOOKS, hooks_f HOOKS, hooks_g HOOKS, hooks_h HOOKS) (_ *Node, hooks HOOKS) {
+func CombineHooks8(gr *Graph, hooks_a HOOKS, hooks_b HOOKS, hooks_c HOOKS, hooks_d HOOKS, hooks_e HOOKS, hooks_f HOOKS, hooks_g HOOKS, hooks_h HOOKS, opts ...NodeOption) (_ *Node, hooks HOOKS) {
 	nd := &Node{
 		Class:  "CombineHooks8",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // ComfySwitchNode - Switch
-func ComfySwitchNode(gr *Graph, on_false COMFY_MATCHTYPE_V3, on_true COMFY_MATCHTYPE_V3, sw bool) (_ *Node, output COMFY_MATCHTYPE_V3) {
+func ComfySwitchNode(gr *Graph, on_false COMFY_MATCHTYPE_V3, on_true COMFY_MATCHTYPE_V3, sw bool, opts ...NodeOption) (_ *Node, output COMFY_MATCHTYPE_V3) {
 	nd := &Node{
 		Class: "ComfySwitchNode",
 		Inputs: map[string]Value{
@@ -10224,11 +10326,12 @@ func ComfySwitchNode(gr *Graph, on_false COMFY_MATCHTYPE_V3, on_true COMFY_MATCH
 			"on_true":  Link(on_true),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, COMFY_MATCHTYPE_V3{NodeID: id, OutPort: 0}
 }
 
-func ConditioningAverage(gr *Graph, conditioning_to CONDITIONING, conditioning_from CONDITIONING, conditioning_to_strength float64) (_ *Node, conditioning CONDITIONING) {
+func ConditioningAverage(gr *Graph, conditioning_to CONDITIONING, conditioning_from CONDITIONING, conditioning_to_strength float64, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningAverage",
 		Inputs: map[string]Value{
@@ -10237,12 +10340,13 @@ func ConditioningAverage(gr *Graph, conditioning_to CONDITIONING, conditioning_f
 			"conditioning_to_strength": Float(conditioning_to_strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningCombine - Conditioning (Combine)
-func ConditioningCombine(gr *Graph, conditioning_1 CONDITIONING, conditioning_2 CONDITIONING) (_ *Node, conditioning CONDITIONING) {
+func ConditioningCombine(gr *Graph, conditioning_1 CONDITIONING, conditioning_2 CONDITIONING, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningCombine",
 		Inputs: map[string]Value{
@@ -10250,12 +10354,13 @@ func ConditioningCombine(gr *Graph, conditioning_1 CONDITIONING, conditioning_2
 			"conditioning_2": Link(conditioning_2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningConcat - Conditioning (Concat)
-func ConditioningConcat(gr *Graph, conditioning_to CONDITIONING, conditioning_from CONDITIONING) (_ *Node, conditioning CONDITIONING) {
+func ConditioningConcat(gr *Graph, conditioning_to CONDITIONING, conditioning_from CONDITIONING, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningConcat",
 		Inputs: map[string]Value{
@@ -10263,12 +10368,13 @@ func ConditioningConcat(gr *Graph, conditioning_to CONDITIONING, conditioning_fr
 			"conditioning_from": Link(conditioning_from),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningSetArea - Conditioning (Set Area)
-func ConditioningSetArea(gr *Graph, conditioning CONDITIONING, width, height, x, y int, strength float64) (_ *Node, out_conditioning CONDITIONING) {
+func ConditioningSetArea(gr *Graph, conditioning CONDITIONING, width, height, x, y int, strength float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetArea",
 		Inputs: map[string]Value{
@@ -10280,12 +10386,13 @@ func ConditioningSetArea(gr *Graph, conditioning CONDITIONING, width, height, x,
 			"strength":     Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningSetAreaPercentage - Conditioning (Set Area with Percentage)
-func ConditioningSetAreaPercentage(gr *Graph, conditioning CONDITIONING, width, height, x, y, strength float64) (_ *Node, out_conditioning CONDITIONING) {
+func ConditioningSetAreaPercentage(gr *Graph, conditioning CONDITIONING, width, height, x, y, strength float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetAreaPercentage",
 		Inputs: map[string]Value{
@@ -10297,11 +10404,12 @@ func ConditioningSetAreaPercentage(gr *Graph, conditioning CONDITIONING, width,
 			"strength":     Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func ConditioningSetAreaPercentageVideo(gr *Graph, conditioning CONDITIONING, width, height, temporal, x, y, z, strength float64) (_ *Node, out_conditioning CONDITIONING) {
+func ConditioningSetAreaPercentageVideo(gr *Graph, conditioning CONDITIONING, width, height, temporal, x, y, z, strength float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetAreaPercentageVideo",
 		Inputs: map[string]Value{
@@ -10315,11 +10423,12 @@ func ConditioningSetAreaPercentageVideo(gr *Graph, conditioning CONDITIONING, wi
 			"strength":     Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func ConditioningSetAreaStrength(gr *Graph, conditioning CONDITIONING, strength float64) (_ *Node, out_conditioning CONDITIONING) {
+func ConditioningSetAreaStrength(gr *Graph, conditioning CONDITIONING, strength float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetAreaStrength",
 		Inputs: map[string]Value{
@@ -10327,12 +10436,13 @@ func ConditioningSetAreaStrength(gr *Graph, conditioning CONDITIONING, strength
 			"strength":     Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningSetDefaultCombine - Cond Set Default Combine
-func ConditioningSetDefaultCombine(gr *Graph, cond CONDITIONING, cond_default CONDITIONING, hooks HOOKS) (_ *Node, conditioning CONDITIONING) {
+func ConditioningSetDefaultCombine(gr *Graph, cond CONDITIONING, cond_default CONDITIONING, hooks HOOKS, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetDefaultCombine",
 		Inputs: map[string]Value{
@@ -10340,12 +10450,13 @@ func ConditioningSetDefaultCombine(gr *Graph, cond CONDITIONING, cond_default CO
 			"cond_DEFAULT": Link(cond_default),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningSetMask - Conditioning (Set Mask)
-func ConditioningSetMask(gr *Graph, conditioning CONDITIONING, mask MASK, strength float64, set_cond_area string) (_ *Node, out_conditioning CONDITIONING) {
+func ConditioningSetMask(gr *Graph, conditioning CONDITIONING, mask MASK, strength float64, set_cond_area string, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetMask",
 		Inputs: map[string]Value{
@@ -10355,12 +10466,13 @@ func ConditioningSetMask(gr *Graph, conditioning CONDITIONING, mask MASK, streng
 			"set_cond_area": String(set_cond_area),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningSetProperties - Cond Set Props
-func ConditioningSetProperties(gr *Graph, cond_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string) (_ *Node, conditioning CONDITIONING) {
+func ConditioningSetProperties(gr *Graph, cond_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetProperties",
 		Inputs: map[string]Value{
@@ -10369,12 +10481,13 @@ func ConditioningSetProperties(gr *Graph, cond_new CONDITIONING, mask MASK, hook
 			"set_cond_area": String(set_cond_area),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ConditioningSetPropertiesAndCombine - Cond Set Props Combine
-func ConditioningSetPropertiesAndCombine(gr *Graph, cond CONDITIONING, cond_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string) (_ *Node, conditioning CONDITIONING) {
+func ConditioningSetPropertiesAndCombine(gr *Graph, cond CONDITIONING, cond_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetPropertiesAndCombine",
 		Inputs: map[string]Value{
@@ -10384,11 +10497,12 @@ func ConditioningSetPropertiesAndCombine(gr *Graph, cond CONDITIONING, cond_new
 			"set_cond_area": String(set_cond_area),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func ConditioningSetTimestepRange(gr *Graph, conditioning CONDITIONING, start, end float64) (_ *Node, out_conditioning CONDITIONING) {
+func ConditioningSetTimestepRange(gr *Graph, conditioning CONDITIONING, start, end float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningSetTimestepRange",
 		Inputs: map[string]Value{
@@ -10397,11 +10511,12 @@ func ConditioningSetTimestepRange(gr *Graph, conditioning CONDITIONING, start, e
 			"end":          Float(end),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func ConditioningStableAudio(gr *Graph, positive CONDITIONING, negative CONDITIONING, seconds_start, seconds_total float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
+func ConditioningStableAudio(gr *Graph, positive CONDITIONING, negative CONDITIONING, seconds_start, seconds_total float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningStableAudio",
 		Inputs: map[string]Value{
@@ -10411,12 +10526,13 @@ func ConditioningStableAudio(gr *Graph, positive CONDITIONING, negative CONDITIO
 			"seconds_total": Float(seconds_total),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // ConditioningTimestepsRange - Timesteps Range
-func ConditioningTimestepsRange(gr *Graph, start_percent, end_percent float64) (_ *Node, timesteps_range TIMESTEPS_RANGE, before_range TIMESTEPS_RANGE, after_range TIMESTEPS_RANGE) {
+func ConditioningTimestepsRange(gr *Graph, start_percent, end_percent float64, opts ...NodeOption) (_ *Node, timesteps_range TIMESTEPS_RANGE, before_range TIMESTEPS_RANGE, after_range TIMESTEPS_RANGE) {
 	nd := &Node{
 		Class: "ConditioningTimestepsRange",
 		Inputs: map[string]Value{
@@ -10424,23 +10540,25 @@ func ConditioningTimestepsRange(gr *Graph, start_percent, end_percent float64) (
 			"end_percent":   Float(end_percent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, TIMESTEPS_RANGE{NodeID: id, OutPort: 0}, TIMESTEPS_RANGE{NodeID: id, OutPort: 1}, TIMESTEPS_RANGE{NodeID: id, OutPort: 2}
 }
 
-func ConditioningZeroOut(gr *Graph, conditioning CONDITIONING) (_ *Node, out_conditioning CONDITIONING) {
+func ConditioningZeroOut(gr *Graph, conditioning CONDITIONING, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ConditioningZeroOut",
 		Inputs: map[string]Value{
 			"conditioning": Link(conditioning),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ContextWindowsManual - Context Windows (Manual)
-func ContextWindowsManual(gr *Graph, model MODEL, context_length, context_overlap int, context_schedule string, context_stride int, closed_loop bool, fuse_method string, dim int, freenoise bool) (_ *Node, out_model MODEL) {
+func ContextWindowsManual(gr *Graph, model MODEL, context_length, context_overlap int, context_schedule string, context_stride int, closed_loop bool, fuse_method string, dim int, freenoise bool, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ContextWindowsManual",
 		Inputs: map[string]Value{
@@ -10455,12 +10573,13 @@ func ContextWindowsManual(gr *Graph, model MODEL, context_length, context_overla
 			"freenoise":        Bool(freenoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // ControlNetApply - Apply ControlNet (OLD)
-func ControlNetApply(gr *Graph, conditioning CONDITIONING, control_net CONTROL_NET, image IMAGE, strength float64) (_ *Node, out_conditioning CONDITIONING) {
+func ControlNetApply(gr *Graph, conditioning CONDITIONING, control_net CONTROL_NET, image IMAGE, strength float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ControlNetApply",
 		Inputs: map[string]Value{
@@ -10470,12 +10589,13 @@ func ControlNetApply(gr *Graph, conditioning CONDITIONING, control_net CONTROL_N
 			"strength":     Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // ControlNetApplyAdvanced - Apply ControlNet
-func ControlNetApplyAdvanced(gr *Graph, positive CONDITIONING, negative CONDITIONING, control_net CONTROL_NET, image IMAGE, vae VAE, strength, start_percent, end_percent float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
+func ControlNetApplyAdvanced(gr *Graph, positive CONDITIONING, negative CONDITIONING, control_net CONTROL_NET, image IMAGE, vae VAE, strength, start_percent, end_percent float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
 	nd := &Node{
 		Class: "ControlNetApplyAdvanced",
 		Inputs: map[string]Value{
@@ -10488,12 +10608,13 @@ func ControlNetApplyAdvanced(gr *Graph, positive CONDITIONING, negative CONDITIO
 			"end_percent":   Float(end_percent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // ControlNetApplySD3 - Apply Controlnet with VAE
-func ControlNetApplySD3(gr *Graph, positive CONDITIONING, negative CONDITIONING, control_net CONTROL_NET, vae VAE, image IMAGE, strength, start_percent, end_percent float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
+func ControlNetApplySD3(gr *Graph, positive CONDITIONING, negative CONDITIONING, control_net CONTROL_NET, vae VAE, image IMAGE, strength, start_percent, end_percent float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
 	nd := &Node{
 		Class: "ControlNetApplySD3",
 		Inputs: map[string]Value{
@@ -10507,11 +10628,12 @@ func ControlNetApplySD3(gr *Graph, positive CONDITIONING, negative CONDITIONING,
 			"end_percent":   Float(end_percent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
-func ControlNetInpaintingAliMamaApply(gr *Graph, positive CONDITIONING, negative CONDITIONING, control_net CONTROL_NET, vae VAE, image IMAGE, mask MASK, strength, start_percent, end_percent float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
+func ControlNetInpaintingAliMamaApply(gr *Graph, positive CONDITIONING, negative CONDITIONING, control_net CONTROL_NET, vae VAE, image IMAGE, mask MASK, strength, start_percent, end_percent float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
 	nd := &Node{
 		Class: "ControlNetInpaintingAliMamaApply",
 		Inputs: map[string]Value{
@@ -10526,23 +10648,25 @@ func ControlNetInpaintingAliMamaApply(gr *Graph, positive CONDITIONING, negative
 			"end_percent":   Float(end_percent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // ControlNetLoader - Load ControlNet Model
-func ControlNetLoader(gr *Graph, control_net_name string) (_ *Node, control_net CONTROL_NET) {
+func ControlNetLoader(gr *Graph, control_net_name string, opts ...NodeOption) (_ *Node, control_net CONTROL_NET) {
 	nd := &Node{
 		Class: "ControlNetLoader",
 		Inputs: map[string]Value{
 			"control_net_name": String(control_net_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONTROL_NET{NodeID: id, OutPort: 0}
 }
 
-func CosmosImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func CosmosImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "CosmosImageToVideoLatent",
 		Inputs: map[string]Value{
@@ -10553,11 +10677,12 @@ func CosmosImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, end_image I
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func CosmosPredict2ImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func CosmosPredict2ImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "CosmosPredict2ImageToVideoLatent",
 		Inputs: map[string]Value{
@@ -10568,12 +10693,13 @@ func CosmosPredict2ImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, end
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // CreateHookKeyframe - Create Hook Keyframe
-func CreateHookKeyframe(gr *Graph, prev_hook_kf HOOK_KEYFRAMES, strength_mult, start_percent float64) (_ *Node, hook_kf HOOK_KEYFRAMES) {
+func CreateHookKeyframe(gr *Graph, prev_hook_kf HOOK_KEYFRAMES, strength_mult, start_percent float64, opts ...NodeOption) (_ *Node, hook_kf HOOK_KEYFRAMES) {
 	nd := &Node{
 		Class: "CreateHookKeyframe",
 		Inputs: map[string]Value{
@@ -10581,12 +10707,13 @@ func CreateHookKeyframe(gr *Graph, prev_hook_kf HOOK_KEYFRAMES, strength_mult, s
 			"start_percent": Float(start_percent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOK_KEYFRAMES{NodeID: id, OutPort: 0}
 }
 
 // CreateHookKeyframesFromFloats - Create Hook Keyframes From Floats
-func CreateHookKeyframesFromFloats(gr *Graph, floats_strength FLOATS, prev_hook_kf HOOK_KEYFRAMES, start_percent, end_percent float64, print_keyframes bool) (_ *Node, hook_kf HOOK_KEYFRAMES) {
+func CreateHookKeyframesFromFloats(gr *Graph, floats_strength FLOATS, prev_hook_kf HOOK_KEYFRAMES, start_percent, end_percent float64, print_keyframes bool, opts ...NodeOption) (_ *Node, hook_kf HOOK_KEYFRAMES) {
 	nd := &Node{
 		Class: "CreateHookKeyframesFromFloats",
 		Inputs: map[string]Value{
@@ -10596,12 +10723,13 @@ func CreateHookKeyframesFromFloats(gr *Graph, floats_strength FLOATS, prev_hook_
 			"print_keyframes": Bool(print_keyframes),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOK_KEYFRAMES{NodeID: id, OutPort: 0}
 }
 
 // CreateHookKeyframesInterpolated - Create Hook Keyframes Interp.
-func CreateHookKeyframesInterpolated(gr *Graph, prev_hook_kf HOOK_KEYFRAMES, strength_start, strength_end float64, interpolation string, start_percent, end_percent float64, keyframes_count int, print_keyframes bool) (_ *Node, hook_kf HOOK_KEYFRAMES) {
+func CreateHookKeyframesInterpolated(gr *Graph, prev_hook_kf HOOK_KEYFRAMES, strength_start, strength_end float64, interpolation string, start_percent, end_percent float64, keyframes_count int, print_keyframes bool, opts ...NodeOption) (_ *Node, hook_kf HOOK_KEYFRAMES) {
 	nd := &Node{
 		Class: "CreateHookKeyframesInterpolated",
 		Inputs: map[string]Value{
@@ -10614,12 +10742,13 @@ func CreateHookKeyframesInterpolated(gr *Graph, prev_hook_kf HOOK_KEYFRAMES, str
 			"print_keyframes": Bool(print_keyframes),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOK_KEYFRAMES{NodeID: id, OutPort: 0}
 }
 
 // CreateHookLora - Create Hook LoRA
-func CreateHookLora(gr *Graph, prev_hooks HOOKS, lora_name string, strength_model, strength_clip float64) (_ *Node, hooks HOOKS) {
+func CreateHookLora(gr *Graph, prev_hooks HOOKS, lora_name string, strength_model, strength_clip float64, opts ...NodeOption) (_ *Node, hooks HOOKS) {
 	nd := &Node{
 		Class: "CreateHookLora",
 		Inputs: map[string]Value{
@@ -10628,12 +10757,13 @@ func CreateHookLora(gr *Graph, prev_hooks HOOKS, lora_name string, strength_mode
 			"strength_clip":  Float(strength_clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // CreateHookLoraModelOnly - Create Hook LoRA (MO)
-func CreateHookLoraModelOnly(gr *Graph, prev_hooks HOOKS, lora_name string, strength_model float64) (_ *Node, hooks HOOKS) {
+func CreateHookLoraModelOnly(gr *Graph, prev_hooks HOOKS, lora_name string, strength_model float64, opts ...NodeOption) (_ *Node, hooks HOOKS) {
 	nd := &Node{
 		Class: "CreateHookLoraModelOnly",
 		Inputs: map[string]Value{
@@ -10641,12 +10771,13 @@ func CreateHookLoraModelOnly(gr *Graph, prev_hooks HOOKS, lora_name string, stre
 			"strength_model": Float(strength_model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // CreateHookModelAsLora - Create Hook Model as LoRA
-func CreateHookModelAsLora(gr *Graph, prev_hooks HOOKS, ckpt_name string, strength_model, strength_clip float64) (_ *Node, hooks HOOKS) {
+func CreateHookModelAsLora(gr *Graph, prev_hooks HOOKS, ckpt_name string, strength_model, strength_clip float64, opts ...NodeOption) (_ *Node, hooks HOOKS) {
 	nd := &Node{
 		Class: "CreateHookModelAsLora",
 		Inputs: map[string]Value{
@@ -10655,12 +10786,13 @@ func CreateHookModelAsLora(gr *Graph, prev_hooks HOOKS, ckpt_name string, streng
 			"strength_clip":  Float(strength_clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // CreateHookModelAsLoraModelOnly - Create Hook Model as LoRA (MO)
-func CreateHookModelAsLoraModelOnly(gr *Graph, prev_hooks HOOKS, ckpt_name string, strength_model float64) (_ *Node, hooks HOOKS) {
+func CreateHookModelAsLoraModelOnly(gr *Graph, prev_hooks HOOKS, ckpt_name string, strength_model float64, opts ...NodeOption) (_ *Node, hooks HOOKS) {
 	nd := &Node{
 		Class: "CreateHookModelAsLoraModelOnly",
 		Inputs: map[string]Value{
@@ -10668,12 +10800,13 @@ func CreateHookModelAsLoraModelOnly(gr *Graph, prev_hooks HOOKS, ckpt_name strin
 			"strength_model": Float(strength_model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // CreateVideo - Create Video
-func CreateVideo(gr *Graph, images IMAGE, audio AUDIO, fps float64) (_ *Node, video VIDEO) {
+func CreateVideo(gr *Graph, images IMAGE, audio AUDIO, fps float64, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "CreateVideo",
 		Inputs: map[string]Value{
@@ -10681,11 +10814,12 @@ func CreateVideo(gr *Graph, images IMAGE, audio AUDIO, fps float64) (_ *Node, vi
 			"fps":    Float(fps),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func CropMask(gr *Graph, mask MASK, x, y, width, height int) (_ *Node, out_mask MASK) {
+func CropMask(gr *Graph, mask MASK, x, y, width, height int, opts ...NodeOption) (_ *Node, out_mask MASK) {
 	nd := &Node{
 		Class: "CropMask",
 		Inputs: map[string]Value{
@@ -10696,24 +10830,26 @@ func CropMask(gr *Graph, mask MASK, x, y, width, height int) (_ *Node, out_mask
 			"height": Int(height),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
 // CustomCombo - Custom Combo
-func CustomCombo(gr *Graph, choice string) (_ *Node, str STRING) {
+func CustomCombo(gr *Graph, choice string, opts ...NodeOption) (_ *Node, str STRING) {
 	nd := &Node{
 		Class: "CustomCombo",
 		Inputs: map[string]Value{
 			"choice": String(choice),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // DiffControlNetLoader - Load ControlNet Model (diff)
-func DiffControlNetLoader(gr *Graph, model MODEL, control_net_name string) (_ *Node, control_net CONTROL_NET) {
+func DiffControlNetLoader(gr *Graph, model MODEL, control_net_name string, opts ...NodeOption) (_ *Node, control_net CONTROL_NET) {
 	nd := &Node{
 		Class: "DiffControlNetLoader",
 		Inputs: map[string]Value{
@@ -10721,43 +10857,47 @@ func DiffControlNetLoader(gr *Graph, model MODEL, control_net_name string) (_ *N
 			"control_net_name": String(control_net_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONTROL_NET{NodeID: id, OutPort: 0}
 }
 
 // DifferentialDiffusion - Differential Diffusion
-func DifferentialDiffusion(gr *Graph, model MODEL, strength float64) (_ *Node, out_model MODEL) {
+func DifferentialDiffusion(gr *Graph, model MODEL, strength float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "DifferentialDiffusion",
 		Inputs: map[string]Value{
 			"model": Link(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func DiffusersLoader(gr *Graph, model_path string) (_ *Node, model MODEL, clip CLIP, vae VAE) {
+func DiffusersLoader(gr *Graph, model_path string, opts ...NodeOption) (_ *Node, model MODEL, clip CLIP, vae VAE) {
 	nd := &Node{
 		Class: "DiffusersLoader",
 		Inputs: map[string]Value{
 			"model_path": String(model_path),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}, CLIP{NodeID: id, OutPort: 1}, VAE{NodeID: id, OutPort: 2}
 }
 
-func DisableNoise(gr *Graph) (_ *Node, noise NOISE) {
+func DisableNoise(gr *Graph, opts ...NodeOption) (_ *Node, noise NOISE) {
 	nd := &Node{
 		Class:  "DisableNoise",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, NOISE{NodeID: id, OutPort: 0}
 }
 
-func DualCFGGuider(gr *Graph, model MODEL, cond1 CONDITIONING, cond2 CONDITIONING, negative CONDITIONING, cfg_conds, cfg_cond2_negative float64, style string) (_ *Node, guider GUIDER) {
+func DualCFGGuider(gr *Graph, model MODEL, cond1 CONDITIONING, cond2 CONDITIONING, negative CONDITIONING, cfg_conds, cfg_cond2_negative float64, style string, opts ...NodeOption) (_ *Node, guider GUIDER) {
 	nd := &Node{
 		Class: "DualCFGGuider",
 		Inputs: map[string]Value{
@@ -10770,11 +10910,12 @@ func DualCFGGuider(gr *Graph, model MODEL, cond1 CONDITIONING, cond2 CONDITIONIN
 			"style":              String(style),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, GUIDER{NodeID: id, OutPort: 0}
 }
 
-func DualCLIPLoader(gr *Graph, clip_name1, clip_name2, typ, device string) (_ *Node, clip CLIP) {
+func DualCLIPLoader(gr *Graph, clip_name1, clip_name2, typ, device string, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "DualCLIPLoader",
 		Inputs: map[string]Value{
@@ -10783,11 +10924,12 @@ func DualCLIPLoader(gr *Graph, clip_name1, clip_name2, typ, device string) (_ *N
 			"type":       String(typ),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func EasyCache(gr *Graph, model MODEL, reuse_threshold, start_percent, end_percent float64, verbose bool) (_ *Node, out_model MODEL) {
+func EasyCache(gr *Graph, model MODEL, reuse_threshold, start_percent, end_percent float64, verbose bool, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "EasyCache",
 		Inputs: map[string]Value{
@@ -10798,11 +10940,12 @@ func EasyCache(gr *Graph, model MODEL, reuse_threshold, start_percent, end_perce
 			"verbose":         Bool(verbose),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func EmptyAceStepLatentAudio(gr *Graph, seconds float64, batch_size int) (_ *Node, latent LATENT) {
+func EmptyAceStepLatentAudio(gr *Graph, seconds float64, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyAceStepLatentAudio",
 		Inputs: map[string]Value{
@@ -10810,12 +10953,13 @@ func EmptyAceStepLatentAudio(gr *Graph, seconds float64, batch_size int) (_ *Nod
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // EmptyAudio - Empty Audio
-func EmptyAudio(gr *Graph, duration float64, sample_rate, channels int) (_ *Node, audio AUDIO) {
+func EmptyAudio(gr *Graph, duration float64, sample_rate, channels int, opts ...NodeOption) (_ *Node, audio AUDIO) {
 	nd := &Node{
 		Class: "EmptyAudio",
 		Inputs: map[string]Value{
@@ -10824,11 +10968,12 @@ func EmptyAudio(gr *Graph, duration float64, sample_rate, channels int) (_ *Node
 			"channels":    Int(channels),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
-func EmptyChromaRadianceLatentImage(gr *Graph, width, height, batch_size int) (_ *Node, latent LATENT) {
+func EmptyChromaRadianceLatentImage(gr *Graph, width, height, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyChromaRadianceLatentImage",
 		Inputs: map[string]Value{
@@ -10837,11 +10982,12 @@ func EmptyChromaRadianceLatentImage(gr *Graph, width, height, batch_size int) (_
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func EmptyCosmosLatentVideo(gr *Graph, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func EmptyCosmosLatentVideo(gr *Graph, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyCosmosLatentVideo",
 		Inputs: map[string]Value{
@@ -10851,12 +10997,13 @@ func EmptyCosmosLatentVideo(gr *Graph, width, height, length, batch_size int) (_
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // EmptyFlux2LatentImage - Empty Flux 2 Latent
-func EmptyFlux2LatentImage(gr *Graph, width, height, batch_size int) (_ *Node, latent LATENT) {
+func EmptyFlux2LatentImage(gr *Graph, width, height, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyFlux2LatentImage",
 		Inputs: map[string]Value{
@@ -10865,11 +11012,12 @@ func EmptyFlux2LatentImage(gr *Graph, width, height, batch_size int) (_ *Node, l
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func EmptyHunyuanImageLatent(gr *Graph, width, height, batch_size int) (_ *Node, latent LATENT) {
+func EmptyHunyuanImageLatent(gr *Graph, width, height, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyHunyuanImageLatent",
 		Inputs: map[string]Value{
@@ -10878,12 +11026,13 @@ func EmptyHunyuanImageLatent(gr *Graph, width, height, batch_size int) (_ *Node,
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // EmptyHunyuanLatentVideo - Empty HunyuanVideo 1.0 Latent
-func EmptyHunyuanLatentVideo(gr *Graph, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func EmptyHunyuanLatentVideo(gr *Graph, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyHunyuanLatentVideo",
 		Inputs: map[string]Value{
@@ -10893,12 +11042,13 @@ func EmptyHunyuanLatentVideo(gr *Graph, width, height, length, batch_size int) (
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // EmptyHunyuanVideo15Latent - Empty HunyuanVideo 1.5 Latent
-func EmptyHunyuanVideo15Latent(gr *Graph, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func EmptyHunyuanVideo15Latent(gr *Graph, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyHunyuanVideo15Latent",
 		Inputs: map[string]Value{
@@ -10908,11 +11058,12 @@ func EmptyHunyuanVideo15Latent(gr *Graph, width, height, length, batch_size int)
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func EmptyImage(gr *Graph, width, height, batch_size, color int) (_ *Node, image IMAGE) {
+func EmptyImage(gr *Graph, width, height, batch_size, color int, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "EmptyImage",
 		Inputs: map[string]Value{
@@ -10922,11 +11073,12 @@ func EmptyImage(gr *Graph, width, height, batch_size, color int) (_ *Node, image
 			"color":      Int(color),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func EmptyLTXVLatentVideo(gr *Graph, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func EmptyLTXVLatentVideo(gr *Graph, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyLTXVLatentVideo",
 		Inputs: map[string]Value{
@@ -10936,12 +11088,13 @@ func EmptyLTXVLatentVideo(gr *Graph, width, height, length, batch_size int) (_ *
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // EmptyLatentAudio - Empty Latent Audio
-func EmptyLatentAudio(gr *Graph, seconds float64, batch_size int) (_ *Node, latent LATENT) {
+func EmptyLatentAudio(gr *Graph, seconds float64, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyLatentAudio",
 		Inputs: map[string]Value{
@@ -10949,11 +11102,12 @@ func EmptyLatentAudio(gr *Graph, seconds float64, batch_size int) (_ *Node, late
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func EmptyLatentHunyuan3Dv2(gr *Graph, resolution, batch_size int) (_ *Node, latent LATENT) {
+func EmptyLatentHunyuan3Dv2(gr *Graph, resolution, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyLatentHunyuan3Dv2",
 		Inputs: map[string]Value{
@@ -10961,12 +11115,13 @@ func EmptyLatentHunyuan3Dv2(gr *Graph, resolution, batch_size int) (_ *Node, lat
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // EmptyLatentImage - Empty Latent Image
-func EmptyLatentImage(gr *Graph, width, height, batch_size int) (_ *Node, latent LATENT) {
+func EmptyLatentImage(gr *Graph, width, height, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyLatentImage",
 		Inputs: map[string]Value{
@@ -10975,11 +11130,12 @@ func EmptyLatentImage(gr *Graph, width, height, batch_size int) (_ *Node, latent
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func EmptyMochiLatentVideo(gr *Graph, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func EmptyMochiLatentVideo(gr *Graph, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyMochiLatentVideo",
 		Inputs: map[string]Value{
@@ -10989,12 +11145,13 @@ func EmptyMochiLatentVideo(gr *Graph, width, height, length, batch_size int) (_
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // EmptyQwenImageLayeredLatentImage - Empty Qwen Image Layered Latent
-func EmptyQwenImageLayeredLatentImage(gr *Graph, width, height, layers, batch_size int) (_ *Node, latent LATENT) {
+func EmptyQwenImageLayeredLatentImage(gr *Graph, width, height, layers, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptyQwenImageLayeredLatentImage",
 		Inputs: map[string]Value{
@@ -11004,11 +11161,12 @@ func EmptyQwenImageLayeredLatentImage(gr *Graph, width, height, layers, batch_si
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func EmptySD3LatentImage(gr *Graph, width, height, batch_size int) (_ *Node, latent LATENT) {
+func EmptySD3LatentImage(gr *Graph, width, height, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "EmptySD3LatentImage",
 		Inputs: map[string]Value{
@@ -11017,11 +11175,12 @@ func EmptySD3LatentImage(gr *Graph, width, height, batch_size int) (_ *Node, lat
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func Epsilon_Scaling(gr *Graph, model MODEL, scaling_factor float64) (_ *Node, out_model MODEL) {
+func Epsilon_Scaling(gr *Graph, model MODEL, scaling_factor float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "Epsilon Scaling",
 		Inputs: map[string]Value{
@@ -11029,11 +11188,12 @@ func Epsilon_Scaling(gr *Graph, model MODEL, scaling_factor float64) (_ *Node, o
 			"scaling_factor": Float(scaling_factor),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ExponentialScheduler(gr *Graph, steps int, sigma_max, sigma_min float64) (_ *Node, sigmas SIGMAS) {
+func ExponentialScheduler(gr *Graph, steps int, sigma_max, sigma_min float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "ExponentialScheduler",
 		Inputs: map[string]Value{
@@ -11042,11 +11202,12 @@ func ExponentialScheduler(gr *Graph, steps int, sigma_max, sigma_min float64) (_
 			"sigma_min": Float(sigma_min),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func ExtendIntermediateSigmas(gr *Graph, sigmas SIGMAS, steps int, start_at_sigma, end_at_sigma float64, spacing string) (_ *Node, out_sigmas SIGMAS) {
+func ExtendIntermediateSigmas(gr *Graph, sigmas SIGMAS, steps int, start_at_sigma, end_at_sigma float64, spacing string, opts ...NodeOption) (_ *Node, out_sigmas SIGMAS) {
 	nd := &Node{
 		Class: "ExtendIntermediateSigmas",
 		Inputs: map[string]Value{
@@ -11057,11 +11218,12 @@ func ExtendIntermediateSigmas(gr *Graph, sigmas SIGMAS, steps int, start_at_sigm
 			"spacing":        String(spacing),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func FeatherMask(gr *Graph, mask MASK, left, top, right, bottom int) (_ *Node, out_mask MASK) {
+func FeatherMask(gr *Graph, mask MASK, left, top, right, bottom int, opts ...NodeOption) (_ *Node, out_mask MASK) {
 	nd := &Node{
 		Class: "FeatherMask",
 		Inputs: map[string]Value{
@@ -11072,23 +11234,25 @@ func FeatherMask(gr *Graph, mask MASK, left, top, right, bottom int) (_ *Node, o
 			"bottom": Int(bottom),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
-func FlipSigmas(gr *Graph, sigmas SIGMAS) (_ *Node, out_sigmas SIGMAS) {
+func FlipSigmas(gr *Graph, sigmas SIGMAS, opts ...NodeOption) (_ *Node, out_sigmas SIGMAS) {
 	nd := &Node{
 		Class: "FlipSigmas",
 		Inputs: map[string]Value{
 			"sigmas": Link(sigmas),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // Flux2MaxImageNode - Flux.2 [max] Image
-func Flux2MaxImageNode(gr *Graph, images IMAGE, prompt string, width, height, seed int, prompt_upsampling bool) (_ *Node, image IMAGE) {
+func Flux2MaxImageNode(gr *Graph, images IMAGE, prompt string, width, height, seed int, prompt_upsampling bool, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "Flux2MaxImageNode",
 		Inputs: map[string]Value{
@@ -11099,12 +11263,13 @@ func Flux2MaxImageNode(gr *Graph, images IMAGE, prompt string, width, height, se
 			"prompt_upsampling": Bool(prompt_upsampling),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // Flux2ProImageNode - Flux.2 [pro] Image
-func Flux2ProImageNode(gr *Graph, images IMAGE, prompt string, width, height, seed int, prompt_upsampling bool) (_ *Node, image IMAGE) {
+func Flux2ProImageNode(gr *Graph, images IMAGE, prompt string, width, height, seed int, prompt_upsampling bool, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "Flux2ProImageNode",
 		Inputs: map[string]Value{
@@ -11115,11 +11280,12 @@ func Flux2ProImageNode(gr *Graph, images IMAGE, prompt string, width, height, se
 			"prompt_upsampling": Bool(prompt_upsampling),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func Flux2Scheduler(gr *Graph, steps, width, height int) (_ *Node, sigmas SIGMAS) {
+func Flux2Scheduler(gr *Graph, steps, width, height int, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "Flux2Scheduler",
 		Inputs: map[string]Value{
@@ -11128,22 +11294,24 @@ func Flux2Scheduler(gr *Graph, steps, width, height int) (_ *Node, sigmas SIGMAS
 			"height": Int(height),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func FluxDisableGuidance(gr *Graph, conditioning CONDITIONING) (_ *Node, out_conditioning CONDITIONING) {
+func FluxDisableGuidance(gr *Graph, conditioning CONDITIONING, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "FluxDisableGuidance",
 		Inputs: map[string]Value{
 			"conditioning": Link(conditioning),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func FluxGuidance(gr *Graph, conditioning CONDITIONING, guidance float64) (_ *Node, out_conditioning CONDITIONING) {
+func FluxGuidance(gr *Graph, conditioning CONDITIONING, guidance float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "FluxGuidance",
 		Inputs: map[string]Value{
@@ -11151,23 +11319,25 @@ func FluxGuidance(gr *Graph, conditioning CONDITIONING, guidance float64) (_ *No
 			"guidance":     Float(guidance),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func FluxKontextImageScale(gr *Graph, image IMAGE) (_ *Node, out_image IMAGE) {
+func FluxKontextImageScale(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "FluxKontextImageScale",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // FluxKontextMaxImageNode - Flux.1 Kontext [max] Image
-func FluxKontextMaxImageNode(gr *Graph, input_image IMAGE, prompt, aspect_ratio string, guidance float64, steps, seed int, prompt_upsampling bool) (_ *Node, image IMAGE) {
+func FluxKontextMaxImageNode(gr *Graph, input_image IMAGE, prompt, aspect_ratio string, guidance float64, steps, seed int, prompt_upsampling bool, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "FluxKontextMaxImageNode",
 		Inputs: map[string]Value{
@@ -11179,12 +11349,13 @@ func FluxKontextMaxImageNode(gr *Graph, input_image IMAGE, prompt, aspect_ratio
 			"prompt_upsampling": Bool(prompt_upsampling),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // FluxKontextMultiReferenceLatentMethod - Edit Model Reference Method
-func FluxKontextMultiReferenceLatentMethod(gr *Graph, conditioning CONDITIONING, reference_latents_method string) (_ *Node, out_conditioning CONDITIONING) {
+func FluxKontextMultiReferenceLatentMethod(gr *Graph, conditioning CONDITIONING, reference_latents_method string, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "FluxKontextMultiReferenceLatentMethod",
 		Inputs: map[string]Value{
@@ -11192,12 +11363,13 @@ func FluxKontextMultiReferenceLatentMethod(gr *Graph, conditioning CONDITIONING,
 			"reference_latents_method": String(reference_latents_method),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // FluxKontextProImageNode - Flux.1 Kontext [pro] Image
-func FluxKontextProImageNode(gr *Graph, input_image IMAGE, prompt, aspect_ratio string, guidance float64, steps, seed int, prompt_upsampling bool) (_ *Node, image IMAGE) {
+func FluxKontextProImageNode(gr *Graph, input_image IMAGE, prompt, aspect_ratio string, guidance float64, steps, seed int, prompt_upsampling bool, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "FluxKontextProImageNode",
 		Inputs: map[string]Value{
@@ -11209,12 +11381,13 @@ func FluxKontextProImageNode(gr *Graph, input_image IMAGE, prompt, aspect_ratio
 			"prompt_upsampling": Bool(prompt_upsampling),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // FluxProExpandNode - Flux.1 Expand Image
-func FluxProExpandNode(gr *Graph, image IMAGE, prompt string, prompt_upsampling bool, top, bottom, left, right int, guidance float64, steps, seed int) (_ *Node, out_image IMAGE) {
+func FluxProExpandNode(gr *Graph, image IMAGE, prompt string, prompt_upsampling bool, top, bottom, left, right int, guidance float64, steps, seed int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "FluxProExpandNode",
 		Inputs: map[string]Value{
@@ -11230,12 +11403,13 @@ func FluxProExpandNode(gr *Graph, image IMAGE, prompt string, prompt_upsampling
 			"seed":              Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // FluxProFillNode - Flux.1 Fill Image
-func FluxProFillNode(gr *Graph, image IMAGE, mask MASK, prompt string, prompt_upsampling bool, guidance float64, steps, seed int) (_ *Node, out_image IMAGE) {
+func FluxProFillNode(gr *Graph, image IMAGE, mask MASK, prompt string, prompt_upsampling bool, guidance float64, steps, seed int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "FluxProFillNode",
 		Inputs: map[string]Value{
@@ -11248,12 +11422,13 @@ func FluxProFillNode(gr *Graph, image IMAGE, mask MASK, prompt string, prompt_up
 			"seed":              Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // FluxProUltraImageNode - Flux 1.1 [pro] Ultra Image
-func FluxProUltraImageNode(gr *Graph, image_prompt IMAGE, prompt string, prompt_upsampling bool, seed int, aspect_ratio string, raw bool, image_prompt_strength float64) (_ *Node, image IMAGE) {
+func FluxProUltraImageNode(gr *Graph, image_prompt IMAGE, prompt string, prompt_upsampling bool, seed int, aspect_ratio string, raw bool, image_prompt_strength float64, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "FluxProUltraImageNode",
 		Inputs: map[string]Value{
@@ -11264,11 +11439,12 @@ func FluxProUltraImageNode(gr *Graph, image_prompt IMAGE, prompt string, prompt_
 			"raw":               Bool(raw),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func FreSca(gr *Graph, model MODEL, scale_low, scale_high float64, freq_cutoff int) (_ *Node, out_model MODEL) {
+func FreSca(gr *Graph, model MODEL, scale_low, scale_high float64, freq_cutoff int, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "FreSca",
 		Inputs: map[string]Value{
@@ -11278,11 +11454,12 @@ func FreSca(gr *Graph, model MODEL, scale_low, scale_high float64, freq_cutoff i
 			"freq_cutoff": Int(freq_cutoff),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func FreeU(gr *Graph, model MODEL, b1, b2, s1, s2 float64) (_ *Node, out_model MODEL) {
+func FreeU(gr *Graph, model MODEL, b1, b2, s1, s2 float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "FreeU",
 		Inputs: map[string]Value{
@@ -11293,11 +11470,12 @@ func FreeU(gr *Graph, model MODEL, b1, b2, s1, s2 float64) (_ *Node, out_model M
 			"s2":    Float(s2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func FreeU_V2(gr *Graph, model MODEL, b1, b2, s1, s2 float64) (_ *Node, out_model MODEL) {
+func FreeU_V2(gr *Graph, model MODEL, b1, b2, s1, s2 float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "FreeU_V2",
 		Inputs: map[string]Value{
@@ -11308,11 +11486,12 @@ func FreeU_V2(gr *Graph, model MODEL, b1, b2, s1, s2 float64) (_ *Node, out_mode
 			"s2":    Float(s2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func GITSScheduler(gr *Graph, coeff float64, steps int, denoise float64) (_ *Node, sigmas SIGMAS) {
+func GITSScheduler(gr *Graph, coeff float64, steps int, denoise float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "GITSScheduler",
 		Inputs: map[string]Value{
@@ -11321,22 +11500,24 @@ func GITSScheduler(gr *Graph, coeff float64, steps int, denoise float64) (_ *Nod
 			"denoise": Float(denoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func GLIGENLoader(gr *Graph, gligen_name string) (_ *Node, gligen GLIGEN) {
+func GLIGENLoader(gr *Graph, gligen_name string, opts ...NodeOption) (_ *Node, gligen GLIGEN) {
 	nd := &Node{
 		Class: "GLIGENLoader",
 		Inputs: map[string]Value{
 			"gligen_name": String(gligen_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, GLIGEN{NodeID: id, OutPort: 0}
 }
 
-func GLIGENTextBoxApply(gr *Graph, conditioning_to CONDITIONING, clip CLIP, gligen_textbox_model GLIGEN, text string, width, height, x, y int) (_ *Node, conditioning CONDITIONING) {
+func GLIGENTextBoxApply(gr *Graph, conditioning_to CONDITIONING, clip CLIP, gligen_textbox_model GLIGEN, text string, width, height, x, y int, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "GLIGENTextBoxApply",
 		Inputs: map[string]Value{
@@ -11350,12 +11531,13 @@ func GLIGENTextBoxApply(gr *Graph, conditioning_to CONDITIONING, clip CLIP, glig
 			"y":                    Int(y),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // GeminiImage2Node - Nano Banana Pro (Google Gemini Image)
-func GeminiImage2Node(gr *Graph, images IMAGE, files GEMINI_INPUT_FILES, prompt string, model string, seed int, aspect_ratio, resolution, response_modalities string, system_prompt string) (_ *Node, image IMAGE, str STRING) {
+func GeminiImage2Node(gr *Graph, images IMAGE, files GEMINI_INPUT_FILES, prompt string, model string, seed int, aspect_ratio, resolution, response_modalities string, system_prompt string, opts ...NodeOption) (_ *Node, image IMAGE, str STRING) {
 	nd := &Node{
 		Class: "GeminiImage2Node",
 		Inputs: map[string]Value{
@@ -11367,12 +11549,13 @@ func GeminiImage2Node(gr *Graph, images IMAGE, files GEMINI_INPUT_FILES, prompt
 			"response_modalities": String(response_modalities),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}
 }
 
 // GeminiImageNode - Nano Banana (Google Gemini Image)
-func GeminiImageNode(gr *Graph, images IMAGE, files GEMINI_INPUT_FILES, prompt string, model string, seed int, aspect_ratio, response_modalities string, system_prompt string) (_ *Node, image IMAGE, str STRING) {
+func GeminiImageNode(gr *Graph, images IMAGE, files GEMINI_INPUT_FILES, prompt string, model string, seed int, aspect_ratio, response_modalities string, system_prompt string, opts ...NodeOption) (_ *Node, image IMAGE, str STRING) {
 	nd := &Node{
 		Class: "GeminiImageNode",
 		Inputs: map[string]Value{
@@ -11381,24 +11564,26 @@ func GeminiImageNode(gr *Graph, images IMAGE, files GEMINI_INPUT_FILES, prompt s
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}
 }
 
 // GeminiInputFiles - Gemini Input Files
-func GeminiInputFiles(gr *Graph, gemini_input_files GEMINI_INPUT_FILES, file string) (_ *Node, out_gemini_input_files GEMINI_INPUT_FILES) {
+func GeminiInputFiles(gr *Graph, gemini_input_files GEMINI_INPUT_FILES, file string, opts ...NodeOption) (_ *Node, out_gemini_input_files GEMINI_INPUT_FILES) {
 	nd := &Node{
 		Class: "GeminiInputFiles",
 		Inputs: map[string]Value{
 			"file": String(file),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, GEMINI_INPUT_FILES{NodeID: id, OutPort: 0}
 }
 
 // GeminiNode - Google Gemini
-func GeminiNode(gr *Graph, images IMAGE, audio AUDIO, video VIDEO, files GEMINI_INPUT_FILES, prompt string, model string, seed int, system_prompt string) (_ *Node, str STRING) {
+func GeminiNode(gr *Graph, images IMAGE, audio AUDIO, video VIDEO, files GEMINI_INPUT_FILES, prompt string, model string, seed int, system_prompt string, opts ...NodeOption) (_ *Node, str STRING) {
 	nd := &Node{
 		Class: "GeminiNode",
 		Inputs: map[string]Value{
@@ -11407,11 +11592,12 @@ func GeminiNode(gr *Graph, images IMAGE, audio AUDIO, video VIDEO, files GEMINI_
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
-func GenerateTracks(gr *Graph, track_mask MASK, width, height int, start_x, start_y, end_x, end_y float64, num_frames, num_tracks int, track_spread float64, bezier bool, mid_x, mid_y float64, interpolation string) (_ *Node, tracks TRACKS, track_length INT) {
+func GenerateTracks(gr *Graph, track_mask MASK, width, height int, start_x, start_y, end_x, end_y float64, num_frames, num_tracks int, track_spread float64, bezier bool, mid_x, mid_y float64, interpolation string, opts ...NodeOption) (_ *Node, tracks TRACKS, track_length INT) {
 	nd := &Node{
 		Class: "GenerateTracks",
 		Inputs: map[string]Value{
@@ -11430,36 +11616,39 @@ func GenerateTracks(gr *Graph, track_mask MASK, width, height int, start_x, star
 			"interpolation": String(interpolation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, TRACKS{NodeID: id, OutPort: 0}, INT{NodeID: id, OutPort: 1}
 }
 
 // GetImageSize - Get Image Size
-func GetImageSize(gr *Graph, image IMAGE) (_ *Node, width INT, height INT, batch_size INT) {
+func GetImageSize(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, width INT, height INT, batch_size INT) {
 	nd := &Node{
 		Class: "GetImageSize",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, INT{NodeID: id, OutPort: 0}, INT{NodeID: id, OutPort: 1}, INT{NodeID: id, OutPort: 2}
 }
 
 // GetVideoComponents - Get Video Components
-func GetVideoComponents(gr *Graph, video VIDEO) (_ *Node, images IMAGE, audio AUDIO, fps FLOAT) {
+func GetVideoComponents(gr *Graph, video VIDEO, opts ...NodeOption) (_ *Node, images IMAGE, audio AUDIO, fps FLOAT) {
 	nd := &Node{
 		Class: "GetVideoComponents",
 		Inputs: map[string]Value{
 			"video": Link(video),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, AUDIO{NodeID: id, OutPort: 1}, FLOAT{NodeID: id, OutPort: 2}
 }
 
 // GrowMask - Grow Mask
-func GrowMask(gr *Graph, mask MASK, expand int, tapered_corners bool) (_ *Node, out_mask MASK) {
+func GrowMask(gr *Graph, mask MASK, expand int, tapered_corners bool, opts ...NodeOption) (_ *Node, out_mask MASK) {
 	nd := &Node{
 		Class: "GrowMask",
 		Inputs: map[string]Value{
@@ -11468,31 +11657,34 @@ func GrowMask(gr *Graph, mask MASK, expand int, tapered_corners bool) (_ *Node,
 			"tapered_corners": Bool(tapered_corners),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
-func Hunyuan3Dv2Conditioning(gr *Graph, clip_vision_output CLIP_VISION_OUTPUT) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
+func Hunyuan3Dv2Conditioning(gr *Graph, clip_vision_output CLIP_VISION_OUTPUT, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
 	nd := &Node{
 		Class: "Hunyuan3Dv2Conditioning",
 		Inputs: map[string]Value{
 			"clip_vision_output": Link(clip_vision_output),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
-func Hunyuan3Dv2ConditioningMultiView(gr *Graph, front CLIP_VISION_OUTPUT, left CLIP_VISION_OUTPUT, back CLIP_VISION_OUTPUT, right CLIP_VISION_OUTPUT) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
+func Hunyuan3Dv2ConditioningMultiView(gr *Graph, front CLIP_VISION_OUTPUT, left CLIP_VISION_OUTPUT, back CLIP_VISION_OUTPUT, right CLIP_VISION_OUTPUT, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
 	nd := &Node{
 		Class:  "Hunyuan3Dv2ConditioningMultiView",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
-func HunyuanImageToVideo(gr *Graph, positive CONDITIONING, vae VAE, start_image IMAGE, width, height, length, batch_size int, guidance_type string) (_ *Node, out_positive CONDITIONING, latent LATENT) {
+func HunyuanImageToVideo(gr *Graph, positive CONDITIONING, vae VAE, start_image IMAGE, width, height, length, batch_size int, guidance_type string, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "HunyuanImageToVideo",
 		Inputs: map[string]Value{
@@ -11505,11 +11697,12 @@ func HunyuanImageToVideo(gr *Graph, positive CONDITIONING, vae VAE, start_image
 			"guidance_type": String(guidance_type),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, LATENT{NodeID: id, OutPort: 1}
 }
 
-func HunyuanRefinerLatent(gr *Graph, positive CONDITIONING, negative CONDITIONING, latent LATENT, noise_augmentation float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
+func HunyuanRefinerLatent(gr *Graph, positive CONDITIONING, negative CONDITIONING, latent LATENT, noise_augmentation float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
 	nd := &Node{
 		Class: "HunyuanRefinerLatent",
 		Inputs: map[string]Value{
@@ -11519,11 +11712,12 @@ func HunyuanRefinerLatent(gr *Graph, positive CONDITIONING, negative CONDITIONIN
 			"noise_augmentation": Float(noise_augmentation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func HunyuanVideo15ImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, clip_vision_output CLIP_VISION_OUTPUT, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func HunyuanVideo15ImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, clip_vision_output CLIP_VISION_OUTPUT, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "HunyuanVideo15ImageToVideo",
 		Inputs: map[string]Value{
@@ -11536,12 +11730,13 @@ func HunyuanVideo15ImageToVideo(gr *Graph, positive CONDITIONING, negative CONDI
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // HunyuanVideo15LatentUpscaleWithModel - Hunyuan Video 15 Latent Upscale With Model
-func HunyuanVideo15LatentUpscaleWithModel(gr *Graph, model LATENT_UPSCALE_MODEL, samples LATENT, upscale_method string, width, height int, crop string) (_ *Node, latent LATENT) {
+func HunyuanVideo15LatentUpscaleWithModel(gr *Graph, model LATENT_UPSCALE_MODEL, samples LATENT, upscale_method string, width, height int, crop string, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "HunyuanVideo15LatentUpscaleWithModel",
 		Inputs: map[string]Value{
@@ -11553,11 +11748,12 @@ func HunyuanVideo15LatentUpscaleWithModel(gr *Graph, model LATENT_UPSCALE_MODEL,
 			"crop":           String(crop),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func HunyuanVideo15SuperResolution(gr *Graph, positive CONDITIONING, negative CONDITIONING, latent LATENT, vae VAE, start_image IMAGE, clip_vision_output CLIP_VISION_OUTPUT, noise_augmentation float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
+func HunyuanVideo15SuperResolution(gr *Graph, positive CONDITIONING, negative CONDITIONING, latent LATENT, vae VAE, start_image IMAGE, clip_vision_output CLIP_VISION_OUTPUT, noise_augmentation float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
 	nd := &Node{
 		Class: "HunyuanVideo15SuperResolution",
 		Inputs: map[string]Value{
@@ -11567,11 +11763,12 @@ func HunyuanVideo15SuperResolution(gr *Graph, positive CONDITIONING, negative CO
 			"noise_augmentation": Float(noise_augmentation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func HyperTile(gr *Graph, model MODEL, tile_size, swap_size, max_depth int, scale_depth bool) (_ *Node, out_model MODEL) {
+func HyperTile(gr *Graph, model MODEL, tile_size, swap_size, max_depth int, scale_depth bool, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "HyperTile",
 		Inputs: map[string]Value{
@@ -11582,11 +11779,12 @@ func HyperTile(gr *Graph, model MODEL, tile_size, swap_size, max_depth int, scal
 			"scale_depth": Bool(scale_depth),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func HypernetworkLoader(gr *Graph, model MODEL, hypernetwork_name string, strength float64) (_ *Node, out_model MODEL) {
+func HypernetworkLoader(gr *Graph, model MODEL, hypernetwork_name string, strength float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "HypernetworkLoader",
 		Inputs: map[string]Value{
@@ -11595,12 +11793,13 @@ func HypernetworkLoader(gr *Graph, model MODEL, hypernetwork_name string, streng
 			"strength":          Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // IdeogramV1 - Ideogram V1
-func IdeogramV1(gr *Graph, prompt string, turbo bool, aspect_ratio, magic_prompt_option string, seed int, negative_prompt string, num_images int) (_ *Node, image IMAGE) {
+func IdeogramV1(gr *Graph, prompt string, turbo bool, aspect_ratio, magic_prompt_option string, seed int, negative_prompt string, num_images int, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "IdeogramV1",
 		Inputs: map[string]Value{
@@ -11608,12 +11807,13 @@ func IdeogramV1(gr *Graph, prompt string, turbo bool, aspect_ratio, magic_prompt
 			"turbo":  Bool(turbo),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // IdeogramV2 - Ideogram V2
-func IdeogramV2(gr *Graph, prompt string, turbo bool, aspect_ratio, resolution, magic_prompt_option string, seed int, style_type string, negative_prompt string, num_images int) (_ *Node, image IMAGE) {
+func IdeogramV2(gr *Graph, prompt string, turbo bool, aspect_ratio, resolution, magic_prompt_option string, seed int, style_type string, negative_prompt string, num_images int, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "IdeogramV2",
 		Inputs: map[string]Value{
@@ -11621,23 +11821,25 @@ func IdeogramV2(gr *Graph, prompt string, turbo bool, aspect_ratio, resolution,
 			"turbo":  Bool(turbo),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // IdeogramV3 - Ideogram V3
-func IdeogramV3(gr *Graph, image IMAGE, mask MASK, character_image IMAGE, character_mask MASK, prompt string, aspect_ratio, resolution, magic_prompt_option string, seed, num_images int, rendering_speed string) (_ *Node, out_image IMAGE) {
+func IdeogramV3(gr *Graph, image IMAGE, mask MASK, character_image IMAGE, character_mask MASK, prompt string, aspect_ratio, resolution, magic_prompt_option string, seed, num_images int, rendering_speed string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "IdeogramV3",
 		Inputs: map[string]Value{
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageAddNoise(gr *Graph, image IMAGE, seed int, strength float64) (_ *Node, out_image IMAGE) {
+func ImageAddNoise(gr *Graph, image IMAGE, seed int, strength float64, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageAddNoise",
 		Inputs: map[string]Value{
@@ -11646,12 +11848,13 @@ func ImageAddNoise(gr *Graph, image IMAGE, seed int, strength float64) (_ *Node,
 			"strength": Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageBatch - Batch Images
-func ImageBatch(gr *Graph, image1 IMAGE, image2 IMAGE) (_ *Node, image IMAGE) {
+func ImageBatch(gr *Graph, image1 IMAGE, image2 IMAGE, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "ImageBatch",
 		Inputs: map[string]Value{
@@ -11659,11 +11862,12 @@ func ImageBatch(gr *Graph, image1 IMAGE, image2 IMAGE) (_ *Node, image IMAGE) {
 			"image2": Link(image2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageBlend(gr *Graph, image1 IMAGE, image2 IMAGE, blend_factor float64, blend_mode string) (_ *Node, image IMAGE) {
+func ImageBlend(gr *Graph, image1 IMAGE, image2 IMAGE, blend_factor float64, blend_mode string, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "ImageBlend",
 		Inputs: map[string]Value{
@@ -11673,11 +11877,12 @@ func ImageBlend(gr *Graph, image1 IMAGE, image2 IMAGE, blend_factor float64, ble
 			"blend_mode":   String(blend_mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageBlur(gr *Graph, image IMAGE, blur_radius int, sigma float64) (_ *Node, out_image IMAGE) {
+func ImageBlur(gr *Graph, image IMAGE, blur_radius int, sigma float64, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageBlur",
 		Inputs: map[string]Value{
@@ -11686,11 +11891,12 @@ func ImageBlur(gr *Graph, image IMAGE, blur_radius int, sigma float64) (_ *Node,
 			"sigma":       Float(sigma),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageColorToMask(gr *Graph, image IMAGE, color int) (_ *Node, mask MASK) {
+func ImageColorToMask(gr *Graph, image IMAGE, color int, opts ...NodeOption) (_ *Node, mask MASK) {
 	nd := &Node{
 		Class: "ImageColorToMask",
 		Inputs: map[string]Value{
@@ -11698,23 +11904,25 @@ func ImageColorToMask(gr *Graph, image IMAGE, color int) (_ *Node, mask MASK) {
 			"color": Int(color),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
 // ImageCompare - Image Compare
-func ImageCompare(gr *Graph, compare_view IMAGECOMPARE, image_a IMAGE, image_b IMAGE) (_ *Node) {
+func ImageCompare(gr *Graph, compare_view IMAGECOMPARE, image_a IMAGE, image_b IMAGE, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "ImageCompare",
 		Inputs: map[string]Value{
 			"compare_view": Link(compare_view),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
-func ImageCompositeMasked(gr *Graph, destination IMAGE, source IMAGE, mask MASK, x, y int, resize_source bool) (_ *Node, image IMAGE) {
+func ImageCompositeMasked(gr *Graph, destination IMAGE, source IMAGE, mask MASK, x, y int, resize_source bool, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "ImageCompositeMasked",
 		Inputs: map[string]Value{
@@ -11725,12 +11933,13 @@ func ImageCompositeMasked(gr *Graph, destination IMAGE, source IMAGE, mask MASK,
 			"resize_source": Bool(resize_source),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageCrop - Image Crop
-func ImageCrop(gr *Graph, image IMAGE, width, height, x, y int) (_ *Node, out_image IMAGE) {
+func ImageCrop(gr *Graph, image IMAGE, width, height, x, y int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageCrop",
 		Inputs: map[string]Value{
@@ -11741,12 +11950,13 @@ func ImageCrop(gr *Graph, image IMAGE, width, height, x, y int) (_ *Node, out_im
 			"y":      Int(y),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageDeduplication - Image Deduplication
-func ImageDeduplication(gr *Graph, images IMAGE, similarity_threshold float64) (_ *Node, out_images IMAGE) {
+func ImageDeduplication(gr *Graph, images IMAGE, similarity_threshold float64, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "ImageDeduplication",
 		Inputs: map[string]Value{
@@ -11754,11 +11964,12 @@ func ImageDeduplication(gr *Graph, images IMAGE, similarity_threshold float64) (
 			"similarity_threshold": Float(similarity_threshold),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageFlip(gr *Graph, image IMAGE, flip_method string) (_ *Node, out_image IMAGE) {
+func ImageFlip(gr *Graph, image IMAGE, flip_method string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageFlip",
 		Inputs: map[string]Value{
@@ -11766,11 +11977,12 @@ func ImageFlip(gr *Graph, image IMAGE, flip_method string) (_ *Node, out_image I
 			"flip_method": String(flip_method),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageFromBatch(gr *Graph, image IMAGE, batch_index, length int) (_ *Node, out_image IMAGE) {
+func ImageFromBatch(gr *Graph, image IMAGE, batch_index, length int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageFromBatch",
 		Inputs: map[string]Value{
@@ -11779,12 +11991,13 @@ func ImageFromBatch(gr *Graph, image IMAGE, batch_index, length int) (_ *Node, o
 			"length":      Int(length),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageGrid - Image Grid
-func ImageGrid(gr *Graph, images IMAGE, columns, cell_width, cell_height, padding int) (_ *Node, out_images IMAGE) {
+func ImageGrid(gr *Graph, images IMAGE, columns, cell_width, cell_height, padding int, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "ImageGrid",
 		Inputs: map[string]Value{
@@ -11795,35 +12008,38 @@ func ImageGrid(gr *Graph, images IMAGE, columns, cell_width, cell_height, paddin
 			"padding":     Int(padding),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageInvert - Invert Image
-func ImageInvert(gr *Graph, image IMAGE) (_ *Node, out_image IMAGE) {
+func ImageInvert(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageInvert",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageOnlyCheckpointLoader - Image Only Checkpoint Loader (img2vid model)
-func ImageOnlyCheckpointLoader(gr *Graph, ckpt_name string) (_ *Node, model MODEL, clip_vision CLIP_VISION, vae VAE) {
+func ImageOnlyCheckpointLoader(gr *Graph, ckpt_name string, opts ...NodeOption) (_ *Node, model MODEL, clip_vision CLIP_VISION, vae VAE) {
 	nd := &Node{
 		Class: "ImageOnlyCheckpointLoader",
 		Inputs: map[string]Value{
 			"ckpt_name": String(ckpt_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}, CLIP_VISION{NodeID: id, OutPort: 1}, VAE{NodeID: id, OutPort: 2}
 }
 
-func ImageOnlyCheckpointSave(gr *Graph, model MODEL, clip_vision CLIP_VISION, vae VAE, filename_prefix string) (_ *Node) {
+func ImageOnlyCheckpointSave(gr *Graph, model MODEL, clip_vision CLIP_VISION, vae VAE, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "ImageOnlyCheckpointSave",
 		Inputs: map[string]Value{
@@ -11833,12 +12049,13 @@ func ImageOnlyCheckpointSave(gr *Graph, model MODEL, clip_vision CLIP_VISION, va
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // ImagePadForOutpaint - Pad Image for Outpainting
-func ImagePadForOutpaint(gr *Graph, image IMAGE, left, top, right, bottom, feathering int) (_ *Node, out_image IMAGE, mask MASK) {
+func ImagePadForOutpaint(gr *Graph, image IMAGE, left, top, right, bottom, feathering int, opts ...NodeOption) (_ *Node, out_image IMAGE, mask MASK) {
 	nd := &Node{
 		Class: "ImagePadForOutpaint",
 		Inputs: map[string]Value{
@@ -11850,11 +12067,12 @@ func ImagePadForOutpaint(gr *Graph, image IMAGE, left, top, right, bottom, feath
 			"feathering": Int(feathering),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, MASK{NodeID: id, OutPort: 1}
 }
 
-func ImageQuantize(gr *Graph, image IMAGE, colors int, dither string) (_ *Node, out_image IMAGE) {
+func ImageQuantize(gr *Graph, image IMAGE, colors int, dither string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageQuantize",
 		Inputs: map[string]Value{
@@ -11863,22 +12081,24 @@ func ImageQuantize(gr *Graph, image IMAGE, colors int, dither string) (_ *Node,
 			"dither": String(dither),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageRGBToYUV(gr *Graph, image IMAGE) (_ *Node, y IMAGE, u IMAGE, v IMAGE) {
+func ImageRGBToYUV(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, y IMAGE, u IMAGE, v IMAGE) {
 	nd := &Node{
 		Class: "ImageRGBToYUV",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, IMAGE{NodeID: id, OutPort: 1}, IMAGE{NodeID: id, OutPort: 2}
 }
 
-func ImageRotate(gr *Graph, image IMAGE, rotation string) (_ *Node, out_image IMAGE) {
+func ImageRotate(gr *Graph, image IMAGE, rotation string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageRotate",
 		Inputs: map[string]Value{
@@ -11886,12 +12106,13 @@ func ImageRotate(gr *Graph, image IMAGE, rotation string) (_ *Node, out_image IM
 			"rotation": String(rotation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageScale - Upscale Image
-func ImageScale(gr *Graph, image IMAGE, upscale_method string, width, height int, crop string) (_ *Node, out_image IMAGE) {
+func ImageScale(gr *Graph, image IMAGE, upscale_method string, width, height int, crop string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageScale",
 		Inputs: map[string]Value{
@@ -11902,12 +12123,13 @@ func ImageScale(gr *Graph, image IMAGE, upscale_method string, width, height int
 			"crop":           String(crop),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageScaleBy - Upscale Image By
-func ImageScaleBy(gr *Graph, image IMAGE, upscale_method string, scale_by float64) (_ *Node, out_image IMAGE) {
+func ImageScaleBy(gr *Graph, image IMAGE, upscale_method string, scale_by float64, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageScaleBy",
 		Inputs: map[string]Value{
@@ -11916,11 +12138,12 @@ func ImageScaleBy(gr *Graph, image IMAGE, upscale_method string, scale_by float6
 			"scale_by":       Float(scale_by),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageScaleToMaxDimension(gr *Graph, image IMAGE, upscale_method string, largest_size int) (_ *Node, out_image IMAGE) {
+func ImageScaleToMaxDimension(gr *Graph, image IMAGE, upscale_method string, largest_size int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageScaleToMaxDimension",
 		Inputs: map[string]Value{
@@ -11929,11 +12152,12 @@ func ImageScaleToMaxDimension(gr *Graph, image IMAGE, upscale_method string, lar
 			"largest_size":   Int(largest_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageScaleToTotalPixels(gr *Graph, image IMAGE, upscale_method string, megapixels float64, resolution_steps int) (_ *Node, out_image IMAGE) {
+func ImageScaleToTotalPixels(gr *Graph, image IMAGE, upscale_method string, megapixels float64, resolution_steps int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageScaleToTotalPixels",
 		Inputs: map[string]Value{
@@ -11943,11 +12167,12 @@ func ImageScaleToTotalPixels(gr *Graph, image IMAGE, upscale_method string, mega
 			"resolution_steps": Int(resolution_steps),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageSharpen(gr *Graph, image IMAGE, sharpen_radius int, sigma, alpha float64) (_ *Node, out_image IMAGE) {
+func ImageSharpen(gr *Graph, image IMAGE, sharpen_radius int, sigma, alpha float64, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageSharpen",
 		Inputs: map[string]Value{
@@ -11957,12 +12182,13 @@ func ImageSharpen(gr *Graph, image IMAGE, sharpen_radius int, sigma, alpha float
 			"alpha":          Float(alpha),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageStitch - Image Stitch
-func ImageStitch(gr *Graph, image1 IMAGE, image2 IMAGE, direction string, match_image_size bool, spacing_width int, spacing_color string) (_ *Node, image IMAGE) {
+func ImageStitch(gr *Graph, image1 IMAGE, image2 IMAGE, direction string, match_image_size bool, spacing_width int, spacing_color string, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "ImageStitch",
 		Inputs: map[string]Value{
@@ -11973,12 +12199,13 @@ func ImageStitch(gr *Graph, image1 IMAGE, image2 IMAGE, direction string, match_
 			"spacing_color":    String(spacing_color),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ImageToMask - Convert Image to Mask
-func ImageToMask(gr *Graph, image IMAGE, channel string) (_ *Node, mask MASK) {
+func ImageToMask(gr *Graph, image IMAGE, channel string, opts ...NodeOption) (_ *Node, mask MASK) {
 	nd := &Node{
 		Class: "ImageToMask",
 		Inputs: map[string]Value{
@@ -11986,12 +12213,13 @@ func ImageToMask(gr *Graph, image IMAGE, channel string) (_ *Node, mask MASK) {
 			"channel": String(channel),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
 // ImageUpscaleWithModel - Upscale Image (using Model)
-func ImageUpscaleWithModel(gr *Graph, upscale_model UPSCALE_MODEL, image IMAGE) (_ *Node, out_image IMAGE) {
+func ImageUpscaleWithModel(gr *Graph, upscale_model UPSCALE_MODEL, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ImageUpscaleWithModel",
 		Inputs: map[string]Value{
@@ -11999,11 +12227,12 @@ func ImageUpscaleWithModel(gr *Graph, upscale_model UPSCALE_MODEL, image IMAGE)
 			"image":         Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ImageYUVToRGB(gr *Graph, y IMAGE, u IMAGE, v IMAGE) (_ *Node, image IMAGE) {
+func ImageYUVToRGB(gr *Graph, y IMAGE, u IMAGE, v IMAGE, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "ImageYUVToRGB",
 		Inputs: map[string]Value{
@@ -12012,11 +12241,12 @@ func ImageYUVToRGB(gr *Graph, y IMAGE, u IMAGE, v IMAGE) (_ *Node, image IMAGE)
 			"V": Link(v),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func InpaintModelConditioning(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, pixels IMAGE, mask MASK, noise_mask bool) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func InpaintModelConditioning(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, pixels IMAGE, mask MASK, noise_mask bool, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "InpaintModelConditioning",
 		Inputs: map[string]Value{
@@ -12028,11 +12258,12 @@ func InpaintModelConditioning(gr *Graph, positive CONDITIONING, negative CONDITI
 			"noise_mask": Bool(noise_mask),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func InstructPixToPixConditioning(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, pixels IMAGE) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func InstructPixToPixConditioning(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, pixels IMAGE, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "InstructPixToPixConditioning",
 		Inputs: map[string]Value{
@@ -12042,23 +12273,25 @@ func InstructPixToPixConditioning(gr *Graph, positive CONDITIONING, negative CON
 			"pixels":   Link(pixels),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func InvertMask(gr *Graph, mask MASK) (_ *Node, out_mask MASK) {
+func InvertMask(gr *Graph, mask MASK, opts ...NodeOption) (_ *Node, out_mask MASK) {
 	nd := &Node{
 		Class: "InvertMask",
 		Inputs: map[string]Value{
 			"mask": Link(mask),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
 // JoinAudioChannels - Join Audio Channels
-func JoinAudioChannels(gr *Graph, audio_left AUDIO, audio_right AUDIO) (_ *Node, audio AUDIO) {
+func JoinAudioChannels(gr *Graph, audio_left AUDIO, audio_right AUDIO, opts ...NodeOption) (_ *Node, audio AUDIO) {
 	nd := &Node{
 		Class: "JoinAudioChannels",
 		Inputs: map[string]Value{
@@ -12066,12 +12299,13 @@ func JoinAudioChannels(gr *Graph, audio_left AUDIO, audio_right AUDIO) (_ *Node,
 			"audio_right": Link(audio_right),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // JoinImageWithAlpha - Join Image with Alpha
-func JoinImageWithAlpha(gr *Graph, image IMAGE, alpha MASK) (_ *Node, out_image IMAGE) {
+func JoinImageWithAlpha(gr *Graph, image IMAGE, alpha MASK, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "JoinImageWithAlpha",
 		Inputs: map[string]Value{
@@ -12079,11 +12313,12 @@ func JoinImageWithAlpha(gr *Graph, image IMAGE, alpha MASK) (_ *Node, out_image
 			"alpha": Link(alpha),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func KSampler(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, latent_image LATENT, seed, steps int, cfg float64, sampler_name, scheduler string, denoise float64) (_ *Node, latent LATENT) {
+func KSampler(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, latent_image LATENT, seed, steps int, cfg float64, sampler_name, scheduler string, denoise float64, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "KSampler",
 		Inputs: map[string]Value{
@@ -12099,12 +12334,13 @@ func KSampler(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONI
 			"denoise":      Float(denoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // KSamplerAdvanced - KSampler (Advanced)
-func KSamplerAdvanced(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, latent_image LATENT, add_noise string, noise_seed, steps int, cfg float64, sampler_name, scheduler string, start_at_step, end_at_step int, return_with_leftover_noise string) (_ *Node, latent LATENT) {
+func KSamplerAdvanced(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, latent_image LATENT, add_noise string, noise_seed, steps int, cfg float64, sampler_name, scheduler string, start_at_step, end_at_step int, return_with_leftover_noise string, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "KSamplerAdvanced",
 		Inputs: map[string]Value{
@@ -12123,22 +12359,24 @@ func KSamplerAdvanced(gr *Graph, model MODEL, positive CONDITIONING, negative CO
 			"return_with_leftover_noise": String(return_with_leftover_noise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func KSamplerSelect(gr *Graph, sampler_name string) (_ *Node, sampler SAMPLER) {
+func KSamplerSelect(gr *Graph, sampler_name string, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "KSamplerSelect",
 		Inputs: map[string]Value{
 			"sampler_name": String(sampler_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func Kandinsky5ImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT, cond_latent LATENT) {
+func Kandinsky5ImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT, cond_latent LATENT) {
 	nd := &Node{
 		Class: "Kandinsky5ImageToVideo",
 		Inputs: map[string]Value{
@@ -12151,11 +12389,12 @@ func Kandinsky5ImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITION
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}, LATENT{NodeID: id, OutPort: 3}
 }
 
-func KarrasScheduler(gr *Graph, steps int, sigma_max, sigma_min, rho float64) (_ *Node, sigmas SIGMAS) {
+func KarrasScheduler(gr *Graph, steps int, sigma_max, sigma_min, rho float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "KarrasScheduler",
 		Inputs: map[string]Value{
@@ -12165,12 +12404,13 @@ func KarrasScheduler(gr *Graph, steps int, sigma_max, sigma_min, rho float64) (_
 			"rho":       Float(rho),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // KlingCameraControlI2VNode - Kling Image to Video (Camera Control)
-func KlingCameraControlI2VNode(gr *Graph, start_frame IMAGE, camera_control CAMERA_CONTROL, prompt, negative_prompt string, cfg_scale float64, aspect_ratio string) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
+func KlingCameraControlI2VNode(gr *Graph, start_frame IMAGE, camera_control CAMERA_CONTROL, prompt, negative_prompt string, cfg_scale float64, aspect_ratio string, opts ...NodeOption) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
 	nd := &Node{
 		Class: "KlingCameraControlI2VNode",
 		Inputs: map[string]Value{
@@ -12182,12 +12422,13 @@ func KlingCameraControlI2VNode(gr *Graph, start_frame IMAGE, camera_control CAME
 			"camera_control":  Link(camera_control),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingCameraControlT2VNode - Kling Text to Video (Camera Control)
-func KlingCameraControlT2VNode(gr *Graph, camera_control CAMERA_CONTROL, prompt, negative_prompt string, cfg_scale float64, aspect_ratio string) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
+func KlingCameraControlT2VNode(gr *Graph, camera_control CAMERA_CONTROL, prompt, negative_prompt string, cfg_scale float64, aspect_ratio string, opts ...NodeOption) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
 	nd := &Node{
 		Class: "KlingCameraControlT2VNode",
 		Inputs: map[string]Value{
@@ -12198,12 +12439,13 @@ func KlingCameraControlT2VNode(gr *Graph, camera_control CAMERA_CONTROL, prompt,
 			"camera_control":  Link(camera_control),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingCameraControls - Kling Camera Controls
-func KlingCameraControls(gr *Graph, camera_control_type string, horizontal_movement, vertical_movement, pan, tilt, roll, zoom float64) (_ *Node, camera_control CAMERA_CONTROL) {
+func KlingCameraControls(gr *Graph, camera_control_type string, horizontal_movement, vertical_movement, pan, tilt, roll, zoom float64, opts ...NodeOption) (_ *Node, camera_control CAMERA_CONTROL) {
 	nd := &Node{
 		Class: "KlingCameraControls",
 		Inputs: map[string]Value{
@@ -12216,12 +12458,13 @@ func KlingCameraControls(gr *Graph, camera_control_type string, horizontal_movem
 			"zoom":                Float(zoom),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CAMERA_CONTROL{NodeID: id, OutPort: 0}
 }
 
 // KlingDualCharacterVideoEffectNode - Kling Dual Character Video Effects
-func KlingDualCharacterVideoEffectNode(gr *Graph, image_left IMAGE, image_right IMAGE, effect_scene, model_name, mode, duration string) (_ *Node, video VIDEO, out_duration STRING) {
+func KlingDualCharacterVideoEffectNode(gr *Graph, image_left IMAGE, image_right IMAGE, effect_scene, model_name, mode, duration string, opts ...NodeOption) (_ *Node, video VIDEO, out_duration STRING) {
 	nd := &Node{
 		Class: "KlingDualCharacterVideoEffectNode",
 		Inputs: map[string]Value{
@@ -12233,12 +12476,13 @@ func KlingDualCharacterVideoEffectNode(gr *Graph, image_left IMAGE, image_right
 			"duration":     String(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}
 }
 
 // KlingImage2VideoNode - Kling Image(First Frame) to Video
-func KlingImage2VideoNode(gr *Graph, start_frame IMAGE, prompt, negative_prompt string, model_name string, cfg_scale float64, mode, aspect_ratio, duration string) (_ *Node, video VIDEO, video_id STRING, out_duration STRING) {
+func KlingImage2VideoNode(gr *Graph, start_frame IMAGE, prompt, negative_prompt string, model_name string, cfg_scale float64, mode, aspect_ratio, duration string, opts ...NodeOption) (_ *Node, video VIDEO, video_id STRING, out_duration STRING) {
 	nd := &Node{
 		Class: "KlingImage2VideoNode",
 		Inputs: map[string]Value{
@@ -12252,12 +12496,13 @@ func KlingImage2VideoNode(gr *Graph, start_frame IMAGE, prompt, negative_prompt
 			"duration":        String(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingImageGenerationNode - Kling Image Generation
-func KlingImageGenerationNode(gr *Graph, image IMAGE, prompt, negative_prompt string, image_type string, image_fidelity, human_fidelity float64, model_name, aspect_ratio string, n int) (_ *Node, out_image IMAGE) {
+func KlingImageGenerationNode(gr *Graph, image IMAGE, prompt, negative_prompt string, image_type string, image_fidelity, human_fidelity float64, model_name, aspect_ratio string, n int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "KlingImageGenerationNode",
 		Inputs: map[string]Value{
@@ -12271,12 +12516,13 @@ func KlingImageGenerationNode(gr *Graph, image IMAGE, prompt, negative_prompt st
 			"n":               Int(n),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // KlingImageToVideoWithAudio - Kling Image(First Frame) to Video with Audio
-func KlingImageToVideoWithAudio(gr *Graph, start_frame IMAGE, model_name string, prompt string, mode, duration string, generate_audio bool) (_ *Node, video VIDEO) {
+func KlingImageToVideoWithAudio(gr *Graph, start_frame IMAGE, model_name string, prompt string, mode, duration string, generate_audio bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "KlingImageToVideoWithAudio",
 		Inputs: map[string]Value{
@@ -12288,12 +12534,13 @@ func KlingImageToVideoWithAudio(gr *Graph, start_frame IMAGE, model_name string,
 			"generate_audio": Bool(generate_audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingLipSyncAudioToVideoNode - Kling Lip Sync Video with Audio
-func KlingLipSyncAudioToVideoNode(gr *Graph, video VIDEO, audio AUDIO, voice_language string) (_ *Node, out_video VIDEO, video_id STRING, duration STRING) {
+func KlingLipSyncAudioToVideoNode(gr *Graph, video VIDEO, audio AUDIO, voice_language string, opts ...NodeOption) (_ *Node, out_video VIDEO, video_id STRING, duration STRING) {
 	nd := &Node{
 		Class: "KlingLipSyncAudioToVideoNode",
 		Inputs: map[string]Value{
@@ -12302,12 +12549,13 @@ func KlingLipSyncAudioToVideoNode(gr *Graph, video VIDEO, audio AUDIO, voice_lan
 			"voice_language": String(voice_language),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingLipSyncTextToVideoNode - Kling Lip Sync Video with Text
-func KlingLipSyncTextToVideoNode(gr *Graph, video VIDEO, text string, voice string, voice_speed float64) (_ *Node, out_video VIDEO, video_id STRING, duration STRING) {
+func KlingLipSyncTextToVideoNode(gr *Graph, video VIDEO, text string, voice string, voice_speed float64, opts ...NodeOption) (_ *Node, out_video VIDEO, video_id STRING, duration STRING) {
 	nd := &Node{
 		Class: "KlingLipSyncTextToVideoNode",
 		Inputs: map[string]Value{
@@ -12317,12 +12565,13 @@ func KlingLipSyncTextToVideoNode(gr *Graph, video VIDEO, text string, voice stri
 			"voice_speed": Float(voice_speed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingMotionControl - Kling Motion Control
-func KlingMotionControl(gr *Graph, reference_image IMAGE, reference_video VIDEO, prompt string, keep_original_sound bool, character_orientation, mode string) (_ *Node, video VIDEO) {
+func KlingMotionControl(gr *Graph, reference_image IMAGE, reference_video VIDEO, prompt string, keep_original_sound bool, character_orientation, mode string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "KlingMotionControl",
 		Inputs: map[string]Value{
@@ -12334,12 +12583,13 @@ func KlingMotionControl(gr *Graph, reference_image IMAGE, reference_video VIDEO,
 			"mode":                  String(mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingOmniProEditVideoNode - Kling Omni Edit Video (Pro)
-func KlingOmniProEditVideoNode(gr *Graph, video VIDEO, reference_images IMAGE, model_name string, prompt string, keep_original_sound bool, resolution string) (_ *Node, out_video VIDEO) {
+func KlingOmniProEditVideoNode(gr *Graph, video VIDEO, reference_images IMAGE, model_name string, prompt string, keep_original_sound bool, resolution string, opts ...NodeOption) (_ *Node, out_video VIDEO) {
 	nd := &Node{
 		Class: "KlingOmniProEditVideoNode",
 		Inputs: map[string]Value{
@@ -12349,12 +12599,13 @@ func KlingOmniProEditVideoNode(gr *Graph, video VIDEO, reference_images IMAGE, m
 			"keep_original_sound": Bool(keep_original_sound),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingOmniProFirstLastFrameNode - Kling Omni First-Last-Frame to Video (Pro)
-func KlingOmniProFirstLastFrameNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, reference_images IMAGE, model_name string, prompt string, duration int, resolution string) (_ *Node, video VIDEO) {
+func KlingOmniProFirstLastFrameNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, reference_images IMAGE, model_name string, prompt string, duration int, resolution string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "KlingOmniProFirstLastFrameNode",
 		Inputs: map[string]Value{
@@ -12364,12 +12615,13 @@ func KlingOmniProFirstLastFrameNode(gr *Graph, first_frame IMAGE, end_frame IMAG
 			"first_frame": Link(first_frame),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingOmniProImageNode - Kling Omni Image (Pro)
-func KlingOmniProImageNode(gr *Graph, reference_images IMAGE, model_name string, prompt string, resolution, aspect_ratio string) (_ *Node, image IMAGE) {
+func KlingOmniProImageNode(gr *Graph, reference_images IMAGE, model_name string, prompt string, resolution, aspect_ratio string, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "KlingOmniProImageNode",
 		Inputs: map[string]Value{
@@ -12379,12 +12631,13 @@ func KlingOmniProImageNode(gr *Graph, reference_images IMAGE, model_name string,
 			"aspect_ratio": String(aspect_ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // KlingOmniProImageToVideoNode - Kling Omni Image to Video (Pro)
-func KlingOmniProImageToVideoNode(gr *Graph, reference_images IMAGE, model_name string, prompt string, aspect_ratio string, duration int, resolution string) (_ *Node, video VIDEO) {
+func KlingOmniProImageToVideoNode(gr *Graph, reference_images IMAGE, model_name string, prompt string, aspect_ratio string, duration int, resolution string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "KlingOmniProImageToVideoNode",
 		Inputs: map[string]Value{
@@ -12395,12 +12648,13 @@ func KlingOmniProImageToVideoNode(gr *Graph, reference_images IMAGE, model_name
 			"reference_images": Link(reference_images),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingOmniProTextToVideoNode - Kling Omni Text to Video (Pro)
-func KlingOmniProTextToVideoNode(gr *Graph, model_name string, prompt string, aspect_ratio, duration, resolution string) (_ *Node, video VIDEO) {
+func KlingOmniProTextToVideoNode(gr *Graph, model_name string, prompt string, aspect_ratio, duration, resolution string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "KlingOmniProTextToVideoNode",
 		Inputs: map[string]Value{
@@ -12410,12 +12664,13 @@ func KlingOmniProTextToVideoNode(gr *Graph, model_name string, prompt string, as
 			"duration":     String(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingOmniProVideoToVideoNode - Kling Omni Video to Video (Pro)
-func KlingOmniProVideoToVideoNode(gr *Graph, reference_video VIDEO, reference_images IMAGE, model_name string, prompt string, aspect_ratio string, duration int, keep_original_sound bool, resolution string) (_ *Node, video VIDEO) {
+func KlingOmniProVideoToVideoNode(gr *Graph, reference_video VIDEO, reference_images IMAGE, model_name string, prompt string, aspect_ratio string, duration int, keep_original_sound bool, resolution string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "KlingOmniProVideoToVideoNode",
 		Inputs: map[string]Value{
@@ -12427,12 +12682,13 @@ func KlingOmniProVideoToVideoNode(gr *Graph, reference_video VIDEO, reference_im
 			"keep_original_sound": Bool(keep_original_sound),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingSingleImageVideoEffectNode - Kling Video Effects
-func KlingSingleImageVideoEffectNode(gr *Graph, image IMAGE, effect_scene, model_name, duration string) (_ *Node, video VIDEO, video_id STRING, out_duration STRING) {
+func KlingSingleImageVideoEffectNode(gr *Graph, image IMAGE, effect_scene, model_name, duration string, opts ...NodeOption) (_ *Node, video VIDEO, video_id STRING, out_duration STRING) {
 	nd := &Node{
 		Class: "KlingSingleImageVideoEffectNode",
 		Inputs: map[string]Value{
@@ -12442,12 +12698,13 @@ func KlingSingleImageVideoEffectNode(gr *Graph, image IMAGE, effect_scene, model
 			"duration":     String(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingStartEndFrameNode - Kling Start-End Frame to Video
-func KlingStartEndFrameNode(gr *Graph, start_frame IMAGE, end_frame IMAGE, prompt, negative_prompt string, cfg_scale float64, aspect_ratio, mode string) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
+func KlingStartEndFrameNode(gr *Graph, start_frame IMAGE, end_frame IMAGE, prompt, negative_prompt string, cfg_scale float64, aspect_ratio, mode string, opts ...NodeOption) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
 	nd := &Node{
 		Class: "KlingStartEndFrameNode",
 		Inputs: map[string]Value{
@@ -12460,12 +12717,13 @@ func KlingStartEndFrameNode(gr *Graph, start_frame IMAGE, end_frame IMAGE, promp
 			"mode":            String(mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingTextToVideoNode - Kling Text to Video
-func KlingTextToVideoNode(gr *Graph, prompt, negative_prompt string, cfg_scale float64, aspect_ratio, mode string) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
+func KlingTextToVideoNode(gr *Graph, prompt, negative_prompt string, cfg_scale float64, aspect_ratio, mode string, opts ...NodeOption) (_ *Node, video VIDEO, video_id STRING, duration STRING) {
 	nd := &Node{
 		Class: "KlingTextToVideoNode",
 		Inputs: map[string]Value{
@@ -12476,12 +12734,13 @@ func KlingTextToVideoNode(gr *Graph, prompt, negative_prompt string, cfg_scale f
 			"mode":            String(mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingTextToVideoWithAudio - Kling Text to Video with Audio
-func KlingTextToVideoWithAudio(gr *Graph, model_name string, prompt string, mode, aspect_ratio, duration string, generate_audio bool) (_ *Node, video VIDEO) {
+func KlingTextToVideoWithAudio(gr *Graph, model_name string, prompt string, mode, aspect_ratio, duration string, generate_audio bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "KlingTextToVideoWithAudio",
 		Inputs: map[string]Value{
@@ -12493,12 +12752,13 @@ func KlingTextToVideoWithAudio(gr *Graph, model_name string, prompt string, mode
 			"generate_audio": Bool(generate_audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // KlingVideoExtendNode - Kling Video Extend
-func KlingVideoExtendNode(gr *Graph, prompt, negative_prompt string, cfg_scale float64, video_id string) (_ *Node, video VIDEO, out_video_id STRING, duration STRING) {
+func KlingVideoExtendNode(gr *Graph, prompt, negative_prompt string, cfg_scale float64, video_id string, opts ...NodeOption) (_ *Node, video VIDEO, out_video_id STRING, duration STRING) {
 	nd := &Node{
 		Class: "KlingVideoExtendNode",
 		Inputs: map[string]Value{
@@ -12508,12 +12768,13 @@ func KlingVideoExtendNode(gr *Graph, prompt, negative_prompt string, cfg_scale f
 			"video_id":        String(video_id),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}
 }
 
 // KlingVirtualTryOnNode - Kling Virtual Try On
-func KlingVirtualTryOnNode(gr *Graph, human_image IMAGE, cloth_image IMAGE, model_name string) (_ *Node, image IMAGE) {
+func KlingVirtualTryOnNode(gr *Graph, human_image IMAGE, cloth_image IMAGE, model_name string, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "KlingVirtualTryOnNode",
 		Inputs: map[string]Value{
@@ -12522,12 +12783,13 @@ func KlingVirtualTryOnNode(gr *Graph, human_image IMAGE, cloth_image IMAGE, mode
 			"model_name":  String(model_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // LTXAVTextEncoderLoader - LTXV Audio Text Encoder Loader
-func LTXAVTextEncoderLoader(gr *Graph, text_encoder, ckpt_name, device string) (_ *Node, clip CLIP) {
+func LTXAVTextEncoderLoader(gr *Graph, text_encoder, ckpt_name, device string, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "LTXAVTextEncoderLoader",
 		Inputs: map[string]Value{
@@ -12536,11 +12798,12 @@ func LTXAVTextEncoderLoader(gr *Graph, text_encoder, ckpt_name, device string) (
 			"device":       String(device),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func LTXVAddGuide(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, latent LATENT, image IMAGE, frame_idx int, strength float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
+func LTXVAddGuide(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, latent LATENT, image IMAGE, frame_idx int, strength float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
 	nd := &Node{
 		Class: "LTXVAddGuide",
 		Inputs: map[string]Value{
@@ -12553,12 +12816,13 @@ func LTXVAddGuide(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae V
 			"strength":  Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // LTXVAudioVAEDecode - LTXV Audio VAE Decode
-func LTXVAudioVAEDecode(gr *Graph, samples LATENT, audio_vae VAE) (_ *Node, audio AUDIO) {
+func LTXVAudioVAEDecode(gr *Graph, samples LATENT, audio_vae VAE, opts ...NodeOption) (_ *Node, audio AUDIO) {
 	nd := &Node{
 		Class: "LTXVAudioVAEDecode",
 		Inputs: map[string]Value{
@@ -12566,12 +12830,13 @@ func LTXVAudioVAEDecode(gr *Graph, samples LATENT, audio_vae VAE) (_ *Node, audi
 			"audio_vae": Link(audio_vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // LTXVAudioVAEEncode - LTXV Audio VAE Encode
-func LTXVAudioVAEEncode(gr *Graph, audio AUDIO, audio_vae VAE) (_ *Node, audio_latent LATENT) {
+func LTXVAudioVAEEncode(gr *Graph, audio AUDIO, audio_vae VAE, opts ...NodeOption) (_ *Node, audio_latent LATENT) {
 	nd := &Node{
 		Class: "LTXVAudioVAEEncode",
 		Inputs: map[string]Value{
@@ -12579,23 +12844,25 @@ func LTXVAudioVAEEncode(gr *Graph, audio AUDIO, audio_vae VAE) (_ *Node, audio_l
 			"audio_vae": Link(audio_vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LTXVAudioVAELoader - LTXV Audio VAE Loader
-func LTXVAudioVAELoader(gr *Graph, ckpt_name string) (_ *Node, audio_vae VAE) {
+func LTXVAudioVAELoader(gr *Graph, ckpt_name string, opts ...NodeOption) (_ *Node, audio_vae VAE) {
 	nd := &Node{
 		Class: "LTXVAudioVAELoader",
 		Inputs: map[string]Value{
 			"ckpt_name": String(ckpt_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VAE{NodeID: id, OutPort: 0}
 }
 
-func LTXVConcatAVLatent(gr *Graph, video_latent LATENT, audio_latent LATENT) (_ *Node, latent LATENT) {
+func LTXVConcatAVLatent(gr *Graph, video_latent LATENT, audio_latent LATENT, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LTXVConcatAVLatent",
 		Inputs: map[string]Value{
@@ -12603,11 +12870,12 @@ func LTXVConcatAVLatent(gr *Graph, video_latent LATENT, audio_latent LATENT) (_
 			"audio_latent": Link(audio_latent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LTXVConditioning(gr *Graph, positive CONDITIONING, negative CONDITIONING, frame_rate float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
+func LTXVConditioning(gr *Graph, positive CONDITIONING, negative CONDITIONING, frame_rate float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
 	nd := &Node{
 		Class: "LTXVConditioning",
 		Inputs: map[string]Value{
@@ -12616,11 +12884,12 @@ func LTXVConditioning(gr *Graph, positive CONDITIONING, negative CONDITIONING, f
 			"frame_rate": Float(frame_rate),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
-func LTXVCropGuides(gr *Graph, positive CONDITIONING, negative CONDITIONING, latent LATENT) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
+func LTXVCropGuides(gr *Graph, positive CONDITIONING, negative CONDITIONING, latent LATENT, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, out_latent LATENT) {
 	nd := &Node{
 		Class: "LTXVCropGuides",
 		Inputs: map[string]Value{
@@ -12629,12 +12898,13 @@ func LTXVCropGuides(gr *Graph, positive CONDITIONING, negative CONDITIONING, lat
 			"latent":   Link(latent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // LTXVEmptyLatentAudio - LTXV Empty Latent Audio
-func LTXVEmptyLatentAudio(gr *Graph, audio_vae VAE, frames_number, frame_rate, batch_size int) (_ *Node, latent LATENT) {
+func LTXVEmptyLatentAudio(gr *Graph, audio_vae VAE, frames_number, frame_rate, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LTXVEmptyLatentAudio",
 		Inputs: map[string]Value{
@@ -12644,11 +12914,12 @@ func LTXVEmptyLatentAudio(gr *Graph, audio_vae VAE, frames_number, frame_rate, b
 			"audio_vae":     Link(audio_vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LTXVImgToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, image IMAGE, width, height, length, batch_size int, strength float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func LTXVImgToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, image IMAGE, width, height, length, batch_size int, strength float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "LTXVImgToVideo",
 		Inputs: map[string]Value{
@@ -12663,11 +12934,12 @@ func LTXVImgToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae
 			"strength":   Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func LTXVImgToVideoInplace(gr *Graph, vae VAE, image IMAGE, latent LATENT, strength float64, bypass bool) (_ *Node, out_latent LATENT) {
+func LTXVImgToVideoInplace(gr *Graph, vae VAE, image IMAGE, latent LATENT, strength float64, bypass bool, opts ...NodeOption) (_ *Node, out_latent LATENT) {
 	nd := &Node{
 		Class: "LTXVImgToVideoInplace",
 		Inputs: map[string]Value{
@@ -12678,11 +12950,12 @@ func LTXVImgToVideoInplace(gr *Graph, vae VAE, image IMAGE, latent LATENT, stren
 			"bypass":   Bool(bypass),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LTXVLatentUpsampler(gr *Graph, samples LATENT, upscale_model LATENT_UPSCALE_MODEL, vae VAE) (_ *Node, latent LATENT) {
+func LTXVLatentUpsampler(gr *Graph, samples LATENT, upscale_model LATENT_UPSCALE_MODEL, vae VAE, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LTXVLatentUpsampler",
 		Inputs: map[string]Value{
@@ -12691,11 +12964,12 @@ func LTXVLatentUpsampler(gr *Graph, samples LATENT, upscale_model LATENT_UPSCALE
 			"vae":           Link(vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LTXVPreprocess(gr *Graph, image IMAGE, img_compression int) (_ *Node, output_image IMAGE) {
+func LTXVPreprocess(gr *Graph, image IMAGE, img_compression int, opts ...NodeOption) (_ *Node, output_image IMAGE) {
 	nd := &Node{
 		Class: "LTXVPreprocess",
 		Inputs: map[string]Value{
@@ -12703,11 +12977,12 @@ func LTXVPreprocess(gr *Graph, image IMAGE, img_compression int) (_ *Node, outpu
 			"img_compression": Int(img_compression),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func LTXVScheduler(gr *Graph, latent LATENT, steps int, max_shift, base_shift float64, stretch bool, terminal float64) (_ *Node, sigmas SIGMAS) {
+func LTXVScheduler(gr *Graph, latent LATENT, steps int, max_shift, base_shift float64, stretch bool, terminal float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "LTXVScheduler",
 		Inputs: map[string]Value{
@@ -12718,22 +12993,24 @@ func LTXVScheduler(gr *Graph, latent LATENT, steps int, max_shift, base_shift fl
 			"terminal":   Float(terminal),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func LTXVSeparateAVLatent(gr *Graph, av_latent LATENT) (_ *Node, video_latent LATENT, audio_latent LATENT) {
+func LTXVSeparateAVLatent(gr *Graph, av_latent LATENT, opts ...NodeOption) (_ *Node, video_latent LATENT, audio_latent LATENT) {
 	nd := &Node{
 		Class: "LTXVSeparateAVLatent",
 		Inputs: map[string]Value{
 			"av_latent": Link(av_latent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, LATENT{NodeID: id, OutPort: 1}
 }
 
-func LaplaceScheduler(gr *Graph, steps int, sigma_max, sigma_min, mu, beta float64) (_ *Node, sigmas SIGMAS) {
+func LaplaceScheduler(gr *Graph, steps int, sigma_max, sigma_min, mu, beta float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "LaplaceScheduler",
 		Inputs: map[string]Value{
@@ -12744,11 +13021,12 @@ func LaplaceScheduler(gr *Graph, steps int, sigma_max, sigma_min, mu, beta float
 			"beta":      Float(beta),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func LatentAdd(gr *Graph, samples1 LATENT, samples2 LATENT) (_ *Node, latent LATENT) {
+func LatentAdd(gr *Graph, samples1 LATENT, samples2 LATENT, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentAdd",
 		Inputs: map[string]Value{
@@ -12756,11 +13034,12 @@ func LatentAdd(gr *Graph, samples1 LATENT, samples2 LATENT) (_ *Node, latent LAT
 			"samples2": Link(samples2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentApplyOperation(gr *Graph, samples LATENT, operation LATENT_OPERATION) (_ *Node, latent LATENT) {
+func LatentApplyOperation(gr *Graph, samples LATENT, operation LATENT_OPERATION, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentApplyOperation",
 		Inputs: map[string]Value{
@@ -12768,11 +13047,12 @@ func LatentApplyOperation(gr *Graph, samples LATENT, operation LATENT_OPERATION)
 			"operation": Link(operation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentApplyOperationCFG(gr *Graph, model MODEL, operation LATENT_OPERATION) (_ *Node, out_model MODEL) {
+func LatentApplyOperationCFG(gr *Graph, model MODEL, operation LATENT_OPERATION, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "LatentApplyOperationCFG",
 		Inputs: map[string]Value{
@@ -12780,11 +13060,12 @@ func LatentApplyOperationCFG(gr *Graph, model MODEL, operation LATENT_OPERATION)
 			"operation": Link(operation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func LatentBatch(gr *Graph, samples1 LATENT, samples2 LATENT) (_ *Node, latent LATENT) {
+func LatentBatch(gr *Graph, samples1 LATENT, samples2 LATENT, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentBatch",
 		Inputs: map[string]Value{
@@ -12792,11 +13073,12 @@ func LatentBatch(gr *Graph, samples1 LATENT, samples2 LATENT) (_ *Node, latent L
 			"samples2": Link(samples2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentBatchSeedBehavior(gr *Graph, samples LATENT, seed_behavior string) (_ *Node, latent LATENT) {
+func LatentBatchSeedBehavior(gr *Graph, samples LATENT, seed_behavior string, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentBatchSeedBehavior",
 		Inputs: map[string]Value{
@@ -12804,12 +13086,13 @@ func LatentBatchSeedBehavior(gr *Graph, samples LATENT, seed_behavior string) (_
 			"seed_behavior": String(seed_behavior),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentBlend - Latent Blend
-func LatentBlend(gr *Graph, samples1 LATENT, samples2 LATENT, blend_factor float64) (_ *Node, latent LATENT) {
+func LatentBlend(gr *Graph, samples1 LATENT, samples2 LATENT, blend_factor float64, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentBlend",
 		Inputs: map[string]Value{
@@ -12818,12 +13101,13 @@ func LatentBlend(gr *Graph, samples1 LATENT, samples2 LATENT, blend_factor float
 			"blend_factor": Float(blend_factor),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentComposite - Latent Composite
-func LatentComposite(gr *Graph, samples_to LATENT, samples_from LATENT, x, y, feather int) (_ *Node, latent LATENT) {
+func LatentComposite(gr *Graph, samples_to LATENT, samples_from LATENT, x, y, feather int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentComposite",
 		Inputs: map[string]Value{
@@ -12834,11 +13118,12 @@ func LatentComposite(gr *Graph, samples_to LATENT, samples_from LATENT, x, y, fe
 			"feather":      Int(feather),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentCompositeMasked(gr *Graph, destination LATENT, source LATENT, mask MASK, x, y int, resize_source bool) (_ *Node, latent LATENT) {
+func LatentCompositeMasked(gr *Graph, destination LATENT, source LATENT, mask MASK, x, y int, resize_source bool, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentCompositeMasked",
 		Inputs: map[string]Value{
@@ -12849,11 +13134,12 @@ func LatentCompositeMasked(gr *Graph, destination LATENT, source LATENT, mask MA
 			"resize_source": Bool(resize_source),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentConcat(gr *Graph, samples1 LATENT, samples2 LATENT, dim string) (_ *Node, latent LATENT) {
+func LatentConcat(gr *Graph, samples1 LATENT, samples2 LATENT, dim string, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentConcat",
 		Inputs: map[string]Value{
@@ -12862,12 +13148,13 @@ func LatentConcat(gr *Graph, samples1 LATENT, samples2 LATENT, dim string) (_ *N
 			"dim":      String(dim),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentCrop - Crop Latent
-func LatentCrop(gr *Graph, samples LATENT, width, height, x, y int) (_ *Node, latent LATENT) {
+func LatentCrop(gr *Graph, samples LATENT, width, height, x, y int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentCrop",
 		Inputs: map[string]Value{
@@ -12878,11 +13165,12 @@ func LatentCrop(gr *Graph, samples LATENT, width, height, x, y int) (_ *Node, la
 			"y":       Int(y),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentCut(gr *Graph, samples LATENT, dim string, index, amount int) (_ *Node, latent LATENT) {
+func LatentCut(gr *Graph, samples LATENT, dim string, index, amount int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentCut",
 		Inputs: map[string]Value{
@@ -12892,11 +13180,12 @@ func LatentCut(gr *Graph, samples LATENT, dim string, index, amount int) (_ *Nod
 			"amount":  Int(amount),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentCutToBatch(gr *Graph, samples LATENT, dim string, slice_size int) (_ *Node, latent LATENT) {
+func LatentCutToBatch(gr *Graph, samples LATENT, dim string, slice_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentCutToBatch",
 		Inputs: map[string]Value{
@@ -12905,12 +13194,13 @@ func LatentCutToBatch(gr *Graph, samples LATENT, dim string, slice_size int) (_
 			"slice_size": Int(slice_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentFlip - Flip Latent
-func LatentFlip(gr *Graph, samples LATENT, flip_method string) (_ *Node, latent LATENT) {
+func LatentFlip(gr *Graph, samples LATENT, flip_method string, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentFlip",
 		Inputs: map[string]Value{
@@ -12918,12 +13208,13 @@ func LatentFlip(gr *Graph, samples LATENT, flip_method string) (_ *Node, latent
 			"flip_method": String(flip_method),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentFromBatch - Latent From Batch
-func LatentFromBatch(gr *Graph, samples LATENT, batch_index, length int) (_ *Node, latent LATENT) {
+func LatentFromBatch(gr *Graph, samples LATENT, batch_index, length int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentFromBatch",
 		Inputs: map[string]Value{
@@ -12932,11 +13223,12 @@ func LatentFromBatch(gr *Graph, samples LATENT, batch_index, length int) (_ *Nod
 			"length":      Int(length),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentInterpolate(gr *Graph, samples1 LATENT, samples2 LATENT, ratio float64) (_ *Node, latent LATENT) {
+func LatentInterpolate(gr *Graph, samples1 LATENT, samples2 LATENT, ratio float64, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentInterpolate",
 		Inputs: map[string]Value{
@@ -12945,11 +13237,12 @@ func LatentInterpolate(gr *Graph, samples1 LATENT, samples2 LATENT, ratio float6
 			"ratio":    Float(ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentMultiply(gr *Graph, samples LATENT, multiplier float64) (_ *Node, latent LATENT) {
+func LatentMultiply(gr *Graph, samples LATENT, multiplier float64, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentMultiply",
 		Inputs: map[string]Value{
@@ -12957,11 +13250,12 @@ func LatentMultiply(gr *Graph, samples LATENT, multiplier float64) (_ *Node, lat
 			"multiplier": Float(multiplier),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentOperationSharpen(gr *Graph, sharpen_radius int, sigma, alpha float64) (_ *Node, latent_operation LATENT_OPERATION) {
+func LatentOperationSharpen(gr *Graph, sharpen_radius int, sigma, alpha float64, opts ...NodeOption) (_ *Node, latent_operation LATENT_OPERATION) {
 	nd := &Node{
 		Class: "LatentOperationSharpen",
 		Inputs: map[string]Value{
@@ -12970,23 +13264,25 @@ func LatentOperationSharpen(gr *Graph, sharpen_radius int, sigma, alpha float64)
 			"alpha":          Float(alpha),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT_OPERATION{NodeID: id, OutPort: 0}
 }
 
-func LatentOperationTonemapReinhard(gr *Graph, multiplier float64) (_ *Node, latent_operation LATENT_OPERATION) {
+func LatentOperationTonemapReinhard(gr *Graph, multiplier float64, opts ...NodeOption) (_ *Node, latent_operation LATENT_OPERATION) {
 	nd := &Node{
 		Class: "LatentOperationTonemapReinhard",
 		Inputs: map[string]Value{
 			"multiplier": Float(multiplier),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT_OPERATION{NodeID: id, OutPort: 0}
 }
 
 // LatentRotate - Rotate Latent
-func LatentRotate(gr *Graph, samples LATENT, rotation string) (_ *Node, latent LATENT) {
+func LatentRotate(gr *Graph, samples LATENT, rotation string, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentRotate",
 		Inputs: map[string]Value{
@@ -12994,11 +13290,12 @@ func LatentRotate(gr *Graph, samples LATENT, rotation string) (_ *Node, latent L
 			"rotation": String(rotation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func LatentSubtract(gr *Graph, samples1 LATENT, samples2 LATENT) (_ *Node, latent LATENT) {
+func LatentSubtract(gr *Graph, samples1 LATENT, samples2 LATENT, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentSubtract",
 		Inputs: map[string]Value{
@@ -13006,12 +13303,13 @@ func LatentSubtract(gr *Graph, samples1 LATENT, samples2 LATENT) (_ *Node, laten
 			"samples2": Link(samples2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentUpscale - Upscale Latent
-func LatentUpscale(gr *Graph, samples LATENT, upscale_method string, width, height int, crop string) (_ *Node, latent LATENT) {
+func LatentUpscale(gr *Graph, samples LATENT, upscale_method string, width, height int, crop string, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentUpscale",
 		Inputs: map[string]Value{
@@ -13022,12 +13320,13 @@ func LatentUpscale(gr *Graph, samples LATENT, upscale_method string, width, heig
 			"crop":           String(crop),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentUpscaleBy - Upscale Latent By
-func LatentUpscaleBy(gr *Graph, samples LATENT, upscale_method string, scale_by float64) (_ *Node, latent LATENT) {
+func LatentUpscaleBy(gr *Graph, samples LATENT, upscale_method string, scale_by float64, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "LatentUpscaleBy",
 		Inputs: map[string]Value{
@@ -13036,23 +13335,25 @@ func LatentUpscaleBy(gr *Graph, samples LATENT, upscale_method string, scale_by
 			"scale_by":       Float(scale_by),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LatentUpscaleModelLoader - Load Latent Upscale Model
-func LatentUpscaleModelLoader(gr *Graph, model_name string) (_ *Node, latent_upscale_model LATENT_UPSCALE_MODEL) {
+func LatentUpscaleModelLoader(gr *Graph, model_name string, opts ...NodeOption) (_ *Node, latent_upscale_model LATENT_UPSCALE_MODEL) {
 	nd := &Node{
 		Class: "LatentUpscaleModelLoader",
 		Inputs: map[string]Value{
 			"model_name": String(model_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT_UPSCALE_MODEL{NodeID: id, OutPort: 0}
 }
 
-func LazyCache(gr *Graph, model MODEL, reuse_threshold, start_percent, end_percent float64, verbose bool) (_ *Node, out_model MODEL) {
+func LazyCache(gr *Graph, model MODEL, reuse_threshold, start_percent, end_percent float64, verbose bool, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "LazyCache",
 		Inputs: map[string]Value{
@@ -13063,12 +13364,13 @@ func LazyCache(gr *Graph, model MODEL, reuse_threshold, start_percent, end_perce
 			"verbose":         Bool(verbose),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // Load3D - Load 3D & Animation
-func Load3D(gr *Graph, image LOAD_3D, model_file string, width, height int) (_ *Node, out_image IMAGE, mask MASK, mesh_path STRING, normal IMAGE, camera_info LOAD3D_CAMERA, recording_video VIDEO) {
+func Load3D(gr *Graph, image LOAD_3D, model_file string, width, height int, opts ...NodeOption) (_ *Node, out_image IMAGE, mask MASK, mesh_path STRING, normal IMAGE, camera_info LOAD3D_CAMERA, recording_video VIDEO) {
 	nd := &Node{
 		Class: "Load3D",
 		Inputs: map[string]Value{
@@ -13078,48 +13380,52 @@ func Load3D(gr *Graph, image LOAD_3D, model_file string, width, height int) (_ *
 			"height":     Int(height),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, MASK{NodeID: id, OutPort: 1}, STRING{NodeID: id, OutPort: 2}, IMAGE{NodeID: id, OutPort: 3}, LOAD3D_CAMERA{NodeID: id, OutPort: 4}, VIDEO{NodeID: id, OutPort: 5}
 }
 
 // LoadAudio - Load Audio
-func LoadAudio(gr *Graph, audio string) (_ *Node, out_audio AUDIO) {
+func LoadAudio(gr *Graph, audio string, opts ...NodeOption) (_ *Node, out_audio AUDIO) {
 	nd := &Node{
 		Class: "LoadAudio",
 		Inputs: map[string]Value{
 			"audio": String(audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // LoadImage - Load Image
-func LoadImage(gr *Graph, image string) (_ *Node, out_image IMAGE, mask MASK) {
+func LoadImage(gr *Graph, image string, opts ...NodeOption) (_ *Node, out_image IMAGE, mask MASK) {
 	nd := &Node{
 		Class: "LoadImage",
 		Inputs: map[string]Value{
 			"image": String(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, MASK{NodeID: id, OutPort: 1}
 }
 
 // LoadImageDataSetFromFolder - Load Image Dataset from Folder
-func LoadImageDataSetFromFolder(gr *Graph, folder string) (_ *Node, images IMAGE) {
+func LoadImageDataSetFromFolder(gr *Graph, folder string, opts ...NodeOption) (_ *Node, images IMAGE) {
 	nd := &Node{
 		Class: "LoadImageDataSetFromFolder",
 		Inputs: map[string]Value{
 			"folder": String(folder),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // LoadImageMask - Load Image (as Mask)
-func LoadImageMask(gr *Graph, image, channel string) (_ *Node, mask MASK) {
+func LoadImageMask(gr *Graph, image, channel string, opts ...NodeOption) (_ *Node, mask MASK) {
 	nd := &Node{
 		Class: "LoadImageMask",
 		Inputs: map[string]Value{
@@ -13127,71 +13433,77 @@ func LoadImageMask(gr *Graph, image, channel string) (_ *Node, mask MASK) {
 			"channel": String(channel),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
 // LoadImageOutput - Load Image (from Outputs)
-func LoadImageOutput(gr *Graph, image string) (_ *Node, out_image IMAGE, mask MASK) {
+func LoadImageOutput(gr *Graph, image string, opts ...NodeOption) (_ *Node, out_image IMAGE, mask MASK) {
 	nd := &Node{
 		Class: "LoadImageOutput",
 		Inputs: map[string]Value{
 			"image": String(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, MASK{NodeID: id, OutPort: 1}
 }
 
 // LoadImageTextDataSetFromFolder - Load Image and Text Dataset from Folder
-func LoadImageTextDataSetFromFolder(gr *Graph, folder string) (_ *Node, images IMAGE, texts STRING) {
+func LoadImageTextDataSetFromFolder(gr *Graph, folder string, opts ...NodeOption) (_ *Node, images IMAGE, texts STRING) {
 	nd := &Node{
 		Class: "LoadImageTextDataSetFromFolder",
 		Inputs: map[string]Value{
 			"folder": String(folder),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}
 }
 
-func LoadLatent(gr *Graph, latent string) (_ *Node, out_latent LATENT) {
+func LoadLatent(gr *Graph, latent string, opts ...NodeOption) (_ *Node, out_latent LATENT) {
 	nd := &Node{
 		Class: "LoadLatent",
 		Inputs: map[string]Value{
 			"latent": String(latent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // LoadTrainingDataset - Load Training Dataset
-func LoadTrainingDataset(gr *Graph, folder_name string) (_ *Node, latents LATENT, conditioning CONDITIONING) {
+func LoadTrainingDataset(gr *Graph, folder_name string, opts ...NodeOption) (_ *Node, latents LATENT, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "LoadTrainingDataset",
 		Inputs: map[string]Value{
 			"folder_name": String(folder_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // LoadVideo - Load Video
-func LoadVideo(gr *Graph, file string) (_ *Node, video VIDEO) {
+func LoadVideo(gr *Graph, file string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "LoadVideo",
 		Inputs: map[string]Value{
 			"file": String(file),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // LoraLoader - Load LoRA
-func LoraLoader(gr *Graph, model MODEL, clip CLIP, lora_name string, strength_model, strength_clip float64) (_ *Node, out_model MODEL, out_clip CLIP) {
+func LoraLoader(gr *Graph, model MODEL, clip CLIP, lora_name string, strength_model, strength_clip float64, opts ...NodeOption) (_ *Node, out_model MODEL, out_clip CLIP) {
 	nd := &Node{
 		Class: "LoraLoader",
 		Inputs: map[string]Value{
@@ -13202,11 +13514,12 @@ func LoraLoader(gr *Graph, model MODEL, clip CLIP, lora_name string, strength_mo
 			"strength_clip":  Float(strength_clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}, CLIP{NodeID: id, OutPort: 1}
 }
 
-func LoraLoaderModelOnly(gr *Graph, model MODEL, lora_name string, strength_model float64) (_ *Node, out_model MODEL) {
+func LoraLoaderModelOnly(gr *Graph, model MODEL, lora_name string, strength_model float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "LoraLoaderModelOnly",
 		Inputs: map[string]Value{
@@ -13215,12 +13528,13 @@ func LoraLoaderModelOnly(gr *Graph, model MODEL, lora_name string, strength_mode
 			"strength_model": Float(strength_model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // LoraModelLoader - Load LoRA Model
-func LoraModelLoader(gr *Graph, model MODEL, lora LORA_MODEL, strength_model float64) (_ *Node, out_model MODEL) {
+func LoraModelLoader(gr *Graph, model MODEL, lora LORA_MODEL, strength_model float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "LoraModelLoader",
 		Inputs: map[string]Value{
@@ -13229,12 +13543,13 @@ func LoraModelLoader(gr *Graph, model MODEL, lora LORA_MODEL, strength_model flo
 			"strength_model": Float(strength_model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // LoraSave - Extract and Save Lora
-func LoraSave(gr *Graph, model_diff MODEL, text_encoder_diff CLIP, filename_prefix string, rank int, lora_type string, bias_diff bool) (_ *Node) {
+func LoraSave(gr *Graph, model_diff MODEL, text_encoder_diff CLIP, filename_prefix string, rank int, lora_type string, bias_diff bool, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "LoraSave",
 		Inputs: map[string]Value{
@@ -13244,12 +13559,13 @@ func LoraSave(gr *Graph, model_diff MODEL, text_encoder_diff CLIP, filename_pref
 			"bias_diff":       Bool(bias_diff),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // LossGraphNode - Plot Loss Graph
-func LossGraphNode(gr *Graph, loss LOSS_MAP, filename_prefix string) (_ *Node) {
+func LossGraphNode(gr *Graph, loss LOSS_MAP, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "LossGraphNode",
 		Inputs: map[string]Value{
@@ -13257,21 +13573,23 @@ func LossGraphNode(gr *Graph, loss LOSS_MAP, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
-func LotusConditioning(gr *Graph) (_ *Node, conditioning CONDITIONING) {
+func LotusConditioning(gr *Graph, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class:  "LotusConditioning",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // LtxvApiImageToVideo - LTXV Image To Video
-func LtxvApiImageToVideo(gr *Graph, image IMAGE, model string, prompt string, duration, resolution, fps string, generate_audio bool) (_ *Node, video VIDEO) {
+func LtxvApiImageToVideo(gr *Graph, image IMAGE, model string, prompt string, duration, resolution, fps string, generate_audio bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "LtxvApiImageToVideo",
 		Inputs: map[string]Value{
@@ -13283,12 +13601,13 @@ func LtxvApiImageToVideo(gr *Graph, image IMAGE, model string, prompt string, du
 			"fps":        String(fps),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // LtxvApiTextToVideo - LTXV Text To Video
-func LtxvApiTextToVideo(gr *Graph, model string, prompt string, duration, resolution, fps string, generate_audio bool) (_ *Node, video VIDEO) {
+func LtxvApiTextToVideo(gr *Graph, model string, prompt string, duration, resolution, fps string, generate_audio bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "LtxvApiTextToVideo",
 		Inputs: map[string]Value{
@@ -13299,12 +13618,13 @@ func LtxvApiTextToVideo(gr *Graph, model string, prompt string, duration, resolu
 			"fps":        String(fps),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // LumaConceptsNode - Luma Concepts
-func LumaConceptsNode(gr *Graph, luma_concepts LUMA_CONCEPTS, concept1, concept2, concept3, concept4 string) (_ *Node, out_luma_concepts LUMA_CONCEPTS) {
+func LumaConceptsNode(gr *Graph, luma_concepts LUMA_CONCEPTS, concept1, concept2, concept3, concept4 string, opts ...NodeOption) (_ *Node, out_luma_concepts LUMA_CONCEPTS) {
 	nd := &Node{
 		Class: "LumaConceptsNode",
 		Inputs: map[string]Value{
@@ -13314,12 +13634,13 @@ func LumaConceptsNode(gr *Graph, luma_concepts LUMA_CONCEPTS, concept1, concept2
 			"concept4": String(concept4),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LUMA_CONCEPTS{NodeID: id, OutPort: 0}
 }
 
 // LumaImageModifyNode - Luma Image to Image
-func LumaImageModifyNode(gr *Graph, image IMAGE, prompt string, image_weight float64, model string, seed int) (_ *Node, out_image IMAGE) {
+func LumaImageModifyNode(gr *Graph, image IMAGE, prompt string, image_weight float64, model string, seed int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "LumaImageModifyNode",
 		Inputs: map[string]Value{
@@ -13330,12 +13651,13 @@ func LumaImageModifyNode(gr *Graph, image IMAGE, prompt string, image_weight flo
 			"seed":         Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // LumaImageNode - Luma Text to Image
-func LumaImageNode(gr *Graph, image_luma_ref LUMA_REF, style_image IMAGE, character_image IMAGE, prompt string, model, aspect_ratio string, seed int, style_image_weight float64) (_ *Node, image IMAGE) {
+func LumaImageNode(gr *Graph, image_luma_ref LUMA_REF, style_image IMAGE, character_image IMAGE, prompt string, model, aspect_ratio string, seed int, style_image_weight float64, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "LumaImageNode",
 		Inputs: map[string]Value{
@@ -13346,12 +13668,13 @@ func LumaImageNode(gr *Graph, image_luma_ref LUMA_REF, style_image IMAGE, charac
 			"style_image_weight": Float(style_image_weight),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // LumaImageToVideoNode - Luma Image to Video
-func LumaImageToVideoNode(gr *Graph, first_image IMAGE, last_image IMAGE, luma_concepts LUMA_CONCEPTS, prompt string, model, resolution, duration string, loop bool, seed int) (_ *Node, video VIDEO) {
+func LumaImageToVideoNode(gr *Graph, first_image IMAGE, last_image IMAGE, luma_concepts LUMA_CONCEPTS, prompt string, model, resolution, duration string, loop bool, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "LumaImageToVideoNode",
 		Inputs: map[string]Value{
@@ -13363,12 +13686,13 @@ func LumaImageToVideoNode(gr *Graph, first_image IMAGE, last_image IMAGE, luma_c
 			"seed":       Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // LumaReferenceNode - Luma Reference
-func LumaReferenceNode(gr *Graph, image IMAGE, luma_ref LUMA_REF, weight float64) (_ *Node, out_luma_ref LUMA_REF) {
+func LumaReferenceNode(gr *Graph, image IMAGE, luma_ref LUMA_REF, weight float64, opts ...NodeOption) (_ *Node, out_luma_ref LUMA_REF) {
 	nd := &Node{
 		Class: "LumaReferenceNode",
 		Inputs: map[string]Value{
@@ -13376,12 +13700,13 @@ func LumaReferenceNode(gr *Graph, image IMAGE, luma_ref LUMA_REF, weight float64
 			"weight": Float(weight),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LUMA_REF{NodeID: id, OutPort: 0}
 }
 
 // LumaVideoNode - Luma Text to Video
-func LumaVideoNode(gr *Graph, luma_concepts LUMA_CONCEPTS, prompt string, model, aspect_ratio, resolution, duration string, loop bool, seed int) (_ *Node, video VIDEO) {
+func LumaVideoNode(gr *Graph, luma_concepts LUMA_CONCEPTS, prompt string, model, aspect_ratio, resolution, duration string, loop bool, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "LumaVideoNode",
 		Inputs: map[string]Value{
@@ -13394,24 +13719,26 @@ func LumaVideoNode(gr *Graph, luma_concepts LUMA_CONCEPTS, prompt string, model,
 			"seed":         Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // Mahiro - Mahiro CFG
-func Mahiro(gr *Graph, model MODEL) (_ *Node, patched_model MODEL) {
+func Mahiro(gr *Graph, model MODEL, opts ...NodeOption) (_ *Node, patched_model MODEL) {
 	nd := &Node{
 		Class: "Mahiro",
 		Inputs: map[string]Value{
 			"model": Link(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // MakeTrainingDataset - Make Training Dataset
-func MakeTrainingDataset(gr *Graph, images IMAGE, vae VAE, clip CLIP, texts string) (_ *Node, latents LATENT, conditioning CONDITIONING) {
+func MakeTrainingDataset(gr *Graph, images IMAGE, vae VAE, clip CLIP, texts string, opts ...NodeOption) (_ *Node, latents LATENT, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "MakeTrainingDataset",
 		Inputs: map[string]Value{
@@ -13420,22 +13747,24 @@ func MakeTrainingDataset(gr *Graph, images IMAGE, vae VAE, clip CLIP, texts stri
 			"clip":   Link(clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
-func ManualSigmas(gr *Graph, sigmas string) (_ *Node, out_sigmas SIGMAS) {
+func ManualSigmas(gr *Graph, sigmas string, opts ...NodeOption) (_ *Node, out_sigmas SIGMAS) {
 	nd := &Node{
 		Class: "ManualSigmas",
 		Inputs: map[string]Value{
 			"sigmas": String(sigmas),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func MaskComposite(gr *Graph, destination MASK, source MASK, x, y int, operation string) (_ *Node, mask MASK) {
+func MaskComposite(gr *Graph, destination MASK, source MASK, x, y int, operation string, opts ...NodeOption) (_ *Node, mask MASK) {
 	nd := &Node{
 		Class: "MaskComposite",
 		Inputs: map[string]Value{
@@ -13446,60 +13775,65 @@ func MaskComposite(gr *Graph, destination MASK, source MASK, x, y int, operation
 			"operation":   String(operation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
 // MaskPreview - Preview Mask
-func MaskPreview(gr *Graph, mask MASK) (_ *Node) {
+func MaskPreview(gr *Graph, mask MASK, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "MaskPreview",
 		Inputs: map[string]Value{
 			"mask": Link(mask),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
 // MaskToImage - Convert Mask to Image
-func MaskToImage(gr *Graph, mask MASK) (_ *Node, image IMAGE) {
+func MaskToImage(gr *Graph, mask MASK, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "MaskToImage",
 		Inputs: map[string]Value{
 			"mask": Link(mask),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // MergeImageLists - Merge Image Lists
-func MergeImageLists(gr *Graph, images IMAGE) (_ *Node, out_images IMAGE) {
+func MergeImageLists(gr *Graph, images IMAGE, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "MergeImageLists",
 		Inputs: map[string]Value{
 			"images": Link(images),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // MergeTextLists - Merge Text Lists
-func MergeTextLists(gr *Graph, texts string) (_ *Node, out_texts STRING) {
+func MergeTextLists(gr *Graph, texts string, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "MergeTextLists",
 		Inputs: map[string]Value{
 			"texts": String(texts),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // MeshyAnimateModelNode - Meshy: Animate Model
-func MeshyAnimateModelNode(gr *Graph, rig_task_id MESHY_RIGGED_TASK_ID, action_id int) (_ *Node, model_file STRING) {
+func MeshyAnimateModelNode(gr *Graph, rig_task_id MESHY_RIGGED_TASK_ID, action_id int, opts ...NodeOption) (_ *Node, model_file STRING, _ Output) {
 	nd := &Node{
 		Class: "MeshyAnimateModelNode",
 		Inputs: map[string]Value{
@@ -13507,12 +13841,13 @@ func MeshyAnimateModelNode(gr *Graph, rig_task_id MESHY_RIGGED_TASK_ID, action_i
 			"action_id":   Int(action_id),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}
+	return nd, STRING{NodeID: id, OutPort: 0}, Output{NodeID: id}
 }
 
 // MeshyImageToModelNode - Meshy: Image to Model
-func MeshyImageToModelNode(gr *Graph, image IMAGE, should_remesh COMFY_DYNAMICCOMBO_V3, should_texture COMFY_DYNAMICCOMBO_V3, model string, symmetry_mode string, pose_mode string, seed int) (_ *Node, model_file STRING, meshy_task_id MESHY_TASK_ID) {
+func MeshyImageToModelNode(gr *Graph, image IMAGE, should_remesh COMFY_DYNAMICCOMBO_V3, should_texture COMFY_DYNAMICCOMBO_V3, model string, symmetry_mode string, pose_mode string, seed int, opts ...NodeOption) (_ *Node, model_file STRING, meshy_task_id MESHY_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "MeshyImageToModelNode",
 		Inputs: map[string]Value{
@@ -13525,12 +13860,13 @@ func MeshyImageToModelNode(gr *Graph, image IMAGE, should_remesh COMFY_DYNAMICCO
 			"seed":           Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // MeshyMultiImageToModelNode - Meshy: Multi-Image to Model
-func MeshyMultiImageToModelNode(gr *Graph, images COMFY_AUTOGROW_V3, should_remesh COMFY_DYNAMICCOMBO_V3, should_texture COMFY_DYNAMICCOMBO_V3, model string, symmetry_mode string, pose_mode string, seed int) (_ *Node, model_file STRING, meshy_task_id MESHY_TASK_ID) {
+func MeshyMultiImageToModelNode(gr *Graph, images COMFY_AUTOGROW_V3, should_remesh COMFY_DYNAMICCOMBO_V3, should_texture COMFY_DYNAMICCOMBO_V3, model string, symmetry_mode string, pose_mode string, seed int, opts ...NodeOption) (_ *Node, model_file STRING, meshy_task_id MESHY_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "MeshyMultiImageToModelNode",
 		Inputs: map[string]Value{
@@ -13543,12 +13879,13 @@ func MeshyMultiImageToModelNode(gr *Graph, images COMFY_AUTOGROW_V3, should_reme
 			"seed":           Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // MeshyRefineNode - Meshy: Refine Draft Model
-func MeshyRefineNode(gr *Graph, meshy_task_id MESHY_TASK_ID, texture_image IMAGE, model string, enable_pbr bool, texture_prompt string) (_ *Node, model_file STRING, out_meshy_task_id MESHY_TASK_ID) {
+func MeshyRefineNode(gr *Graph, meshy_task_id MESHY_TASK_ID, texture_image IMAGE, model string, enable_pbr bool, texture_prompt string, opts ...NodeOption) (_ *Node, model_file STRING, out_meshy_task_id MESHY_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "MeshyRefineNode",
 		Inputs: map[string]Value{
@@ -13558,12 +13895,13 @@ func MeshyRefineNode(gr *Graph, meshy_task_id MESHY_TASK_ID, texture_image IMAGE
 			"texture_prompt": String(texture_prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // MeshyRigModelNode - Meshy: Rig Model
-func MeshyRigModelNode(gr *Graph, meshy_task_id MESHY_TASK_ID, texture_image IMAGE, height_meters float64) (_ *Node, model_file STRING, rig_task_id MESHY_RIGGED_TASK_ID) {
+func MeshyRigModelNode(gr *Graph, meshy_task_id MESHY_TASK_ID, texture_image IMAGE, height_meters float64, opts ...NodeOption) (_ *Node, model_file STRING, rig_task_id MESHY_RIGGED_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "MeshyRigModelNode",
 		Inputs: map[string]Value{
@@ -13571,12 +13909,13 @@ func MeshyRigModelNode(gr *Graph, meshy_task_id MESHY_TASK_ID, texture_image IMA
 			"height_meters": Float(height_meters),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_RIGGED_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_RIGGED_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // MeshyTextToModelNode - Meshy: Text to Model
-func MeshyTextToModelNode(gr *Graph, should_remesh COMFY_DYNAMICCOMBO_V3, model string, prompt string, style string, symmetry_mode, pose_mode string, seed int) (_ *Node, model_file STRING, meshy_task_id MESHY_TASK_ID) {
+func MeshyTextToModelNode(gr *Graph, should_remesh COMFY_DYNAMICCOMBO_V3, model string, prompt string, style string, symmetry_mode, pose_mode string, seed int, opts ...NodeOption) (_ *Node, model_file STRING, meshy_task_id MESHY_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "MeshyTextToModelNode",
 		Inputs: map[string]Value{
@@ -13589,12 +13928,13 @@ func MeshyTextToModelNode(gr *Graph, should_remesh COMFY_DYNAMICCOMBO_V3, model
 			"seed":          Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MESHY_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // MeshyTextureNode - Meshy: Texture Model
-func MeshyTextureNode(gr *Graph, meshy_task_id MESHY_TASK_ID, image_style IMAGE, model string, enable_original_uv, pbr bool, text_style_prompt string) (_ *Node, model_file STRING, out_meshy_task_id MODEL_TASK_ID) {
+func MeshyTextureNode(gr *Graph, meshy_task_id MESHY_TASK_ID, image_style IMAGE, model string, enable_original_uv, pbr bool, text_style_prompt string, opts ...NodeOption) (_ *Node, model_file STRING, out_meshy_task_id MODEL_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "MeshyTextureNode",
 		Inputs: map[string]Value{
@@ -13605,24 +13945,26 @@ func MeshyTextureNode(gr *Graph, meshy_task_id MESHY_TASK_ID, image_style IMAGE,
 			"text_style_prompt":  String(text_style_prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // MinimaxHailuoVideoNode - MiniMax Hailuo Video
-func MinimaxHailuoVideoNode(gr *Graph, first_frame_image IMAGE, prompt_text string, seed int, prompt_optimizer bool, duration, resolution string) (_ *Node, video VIDEO) {
+func MinimaxHailuoVideoNode(gr *Graph, first_frame_image IMAGE, prompt_text string, seed int, prompt_optimizer bool, duration, resolution string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "MinimaxHailuoVideoNode",
 		Inputs: map[string]Value{
 			"prompt_text": String(prompt_text),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // MinimaxImageToVideoNode - MiniMax Image to Video
-func MinimaxImageToVideoNode(gr *Graph, image IMAGE, prompt_text string, model string, seed int) (_ *Node, video VIDEO) {
+func MinimaxImageToVideoNode(gr *Graph, image IMAGE, prompt_text string, model string, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "MinimaxImageToVideoNode",
 		Inputs: map[string]Value{
@@ -13631,12 +13973,13 @@ func MinimaxImageToVideoNode(gr *Graph, image IMAGE, prompt_text string, model s
 			"model":       String(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // MinimaxTextToVideoNode - MiniMax Text to Video
-func MinimaxTextToVideoNode(gr *Graph, prompt_text string, model string, seed int) (_ *Node, video VIDEO) {
+func MinimaxTextToVideoNode(gr *Graph, prompt_text string, model string, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "MinimaxTextToVideoNode",
 		Inputs: map[string]Value{
@@ -13644,11 +13987,12 @@ func MinimaxTextToVideoNode(gr *Graph, prompt_text string, model string, seed in
 			"model":       String(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func ModelComputeDtype(gr *Graph, model MODEL, dtype string) (_ *Node, out_model MODEL) {
+func ModelComputeDtype(gr *Graph, model MODEL, dtype string, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelComputeDtype",
 		Inputs: map[string]Value{
@@ -13656,11 +14000,12 @@ func ModelComputeDtype(gr *Graph, model MODEL, dtype string) (_ *Node, out_model
 			"dtype": String(dtype),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeAdd(gr *Graph, model1 MODEL, model2 MODEL) (_ *Node, model MODEL) {
+func ModelMergeAdd(gr *Graph, model1 MODEL, model2 MODEL, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeAdd",
 		Inputs: map[string]Value{
@@ -13668,11 +14013,12 @@ func ModelMergeAdd(gr *Graph, model1 MODEL, model2 MODEL) (_ *Node, model MODEL)
 			"model2": Link(model2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeAuraflow(gr *Graph, model1 MODEL, model2 MODEL, init_x_linear_, positional_encoding, cond_seq_linear_, register_tokens, t_embedder_, double_layers_0_, double_layers_1_, double_layers_2_, double_layers_3_, single_layers_0_, single_layers_1_, single_layers_2_, single_layers_3_, single_layers_4_, single_layers_5_, single_layers_6_, single_layers_7_, single_layers_8_, single_layers_9_, single_layers_10_, single_layers_11_, single_layers_12_, single_layers_13_, single_layers_14_, single_layers_15_, single_layers_16_, single_layers_17_, single_layers_18_, single_layers_19_, single_layers_20_, single_layers_21_, single_layers_22_, single_layers_23_, single_layers_24_, single_layers_25_, single_layers_26_, single_layers_27_, single_layers_28_, single_layers_29_, single_layers_30_, single_layers_31_, modf_, final_linear_ float64) (_ *Node, model MODEL) {
+func ModelMergeAuraflow(gr *Graph, model1 MODEL, model2 MODEL, init_x_linear_, positional_encoding, cond_seq_linear_, register_tokens, t_embedder_, double_layers_0_, double_layers_1_, double_layers_2_, double_layers_3_, single_layers_0_, single_layers_1_, single_layers_2_, single_layers_3_, single_layers_4_, single_layers_5_, single_layers_6_, single_layers_7_, single_layers_8_, single_layers_9_, single_layers_10_, single_layers_11_, single_layers_12_, single_layers_13_, single_layers_14_, single_layers_15_, single_layers_16_, single_layers_17_, single_layers_18_, single_layers_19_, single_layers_20_, single_layers_21_, single_layers_22_, single_layers_23_, single_layers_24_, single_layers_25_, single_layers_26_, single_layers_27_, single_layers_28_, single_layers_29_, single_layers_30_, single_layers_31_, modf_, final_linear_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeAuraflow",
 		Inputs: map[string]Value{
@@ -13723,11 +14069,12 @@ func ModelMergeAuraflow(gr *Graph, model1 MODEL, model2 MODEL, init_x_linear_, p
 			"final_linear.":       Float(final_linear_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeBlocks(gr *Graph, model1 MODEL, model2 MODEL, input, middle, out float64) (_ *Node, model MODEL) {
+func ModelMergeBlocks(gr *Graph, model1 MODEL, model2 MODEL, input, middle, out float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeBlocks",
 		Inputs: map[string]Value{
@@ -13738,11 +14085,12 @@ func ModelMergeBlocks(gr *Graph, model1 MODEL, model2 MODEL, input, middle, out
 			"out":    Float(out),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeCosmos14B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, extra_pos_embedder_, x_embedder_, t_embedder_, affline_norm_, blocks_block0_, blocks_block1_, blocks_block2_, blocks_block3_, blocks_block4_, blocks_block5_, blocks_block6_, blocks_block7_, blocks_block8_, blocks_block9_, blocks_block10_, blocks_block11_, blocks_block12_, blocks_block13_, blocks_block14_, blocks_block15_, blocks_block16_, blocks_block17_, blocks_block18_, blocks_block19_, blocks_block20_, blocks_block21_, blocks_block22_, blocks_block23_, blocks_block24_, blocks_block25_, blocks_block26_, blocks_block27_, blocks_block28_, blocks_block29_, blocks_block30_, blocks_block31_, blocks_block32_, blocks_block33_, blocks_block34_, blocks_block35_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeCosmos14B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, extra_pos_embedder_, x_embedder_, t_embedder_, affline_norm_, blocks_block0_, blocks_block1_, blocks_block2_, blocks_block3_, blocks_block4_, blocks_block5_, blocks_block6_, blocks_block7_, blocks_block8_, blocks_block9_, blocks_block10_, blocks_block11_, blocks_block12_, blocks_block13_, blocks_block14_, blocks_block15_, blocks_block16_, blocks_block17_, blocks_block18_, blocks_block19_, blocks_block20_, blocks_block21_, blocks_block22_, blocks_block23_, blocks_block24_, blocks_block25_, blocks_block26_, blocks_block27_, blocks_block28_, blocks_block29_, blocks_block30_, blocks_block31_, blocks_block32_, blocks_block33_, blocks_block34_, blocks_block35_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeCosmos14B",
 		Inputs: map[string]Value{
@@ -13792,11 +14140,12 @@ func ModelMergeCosmos14B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, e
 			"final_layer.":        Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeCosmos7B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, extra_pos_embedder_, x_embedder_, t_embedder_, affline_norm_, blocks_block0_, blocks_block1_, blocks_block2_, blocks_block3_, blocks_block4_, blocks_block5_, blocks_block6_, blocks_block7_, blocks_block8_, blocks_block9_, blocks_block10_, blocks_block11_, blocks_block12_, blocks_block13_, blocks_block14_, blocks_block15_, blocks_block16_, blocks_block17_, blocks_block18_, blocks_block19_, blocks_block20_, blocks_block21_, blocks_block22_, blocks_block23_, blocks_block24_, blocks_block25_, blocks_block26_, blocks_block27_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeCosmos7B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, extra_pos_embedder_, x_embedder_, t_embedder_, affline_norm_, blocks_block0_, blocks_block1_, blocks_block2_, blocks_block3_, blocks_block4_, blocks_block5_, blocks_block6_, blocks_block7_, blocks_block8_, blocks_block9_, blocks_block10_, blocks_block11_, blocks_block12_, blocks_block13_, blocks_block14_, blocks_block15_, blocks_block16_, blocks_block17_, blocks_block18_, blocks_block19_, blocks_block20_, blocks_block21_, blocks_block22_, blocks_block23_, blocks_block24_, blocks_block25_, blocks_block26_, blocks_block27_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeCosmos7B",
 		Inputs: map[string]Value{
@@ -13838,11 +14187,12 @@ func ModelMergeCosmos7B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, ex
 			"final_layer.":        Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeCosmosPredict2_14B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, x_embedder_, t_embedder_, t_embedding_norm_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, blocks_28_, blocks_29_, blocks_30_, blocks_31_, blocks_32_, blocks_33_, blocks_34_, blocks_35_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeCosmosPredict2_14B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, x_embedder_, t_embedder_, t_embedding_norm_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, blocks_28_, blocks_29_, blocks_30_, blocks_31_, blocks_32_, blocks_33_, blocks_34_, blocks_35_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeCosmosPredict2_14B",
 		Inputs: map[string]Value{
@@ -13891,11 +14241,12 @@ func ModelMergeCosmosPredict2_14B(gr *Graph, model1 MODEL, model2 MODEL, pos_emb
 			"final_layer.":      Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeCosmosPredict2_2B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, x_embedder_, t_embedder_, t_embedding_norm_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeCosmosPredict2_2B(gr *Graph, model1 MODEL, model2 MODEL, pos_embedder_, x_embedder_, t_embedder_, t_embedding_norm_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeCosmosPredict2_2B",
 		Inputs: map[string]Value{
@@ -13936,11 +14287,12 @@ func ModelMergeCosmosPredict2_2B(gr *Graph, model1 MODEL, model2 MODEL, pos_embe
 			"final_layer.":      Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeFlux1(gr *Graph, model1 MODEL, model2 MODEL, img_in_, time_in_, guidance_in, vector_in_, txt_in_, double_blocks_0_, double_blocks_1_, double_blocks_2_, double_blocks_3_, double_blocks_4_, double_blocks_5_, double_blocks_6_, double_blocks_7_, double_blocks_8_, double_blocks_9_, double_blocks_10_, double_blocks_11_, double_blocks_12_, double_blocks_13_, double_blocks_14_, double_blocks_15_, double_blocks_16_, double_blocks_17_, double_blocks_18_, single_blocks_0_, single_blocks_1_, single_blocks_2_, single_blocks_3_, single_blocks_4_, single_blocks_5_, single_blocks_6_, single_blocks_7_, single_blocks_8_, single_blocks_9_, single_blocks_10_, single_blocks_11_, single_blocks_12_, single_blocks_13_, single_blocks_14_, single_blocks_15_, single_blocks_16_, single_blocks_17_, single_blocks_18_, single_blocks_19_, single_blocks_20_, single_blocks_21_, single_blocks_22_, single_blocks_23_, single_blocks_24_, single_blocks_25_, single_blocks_26_, single_blocks_27_, single_blocks_28_, single_blocks_29_, single_blocks_30_, single_blocks_31_, single_blocks_32_, single_blocks_33_, single_blocks_34_, single_blocks_35_, single_blocks_36_, single_blocks_37_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeFlux1(gr *Graph, model1 MODEL, model2 MODEL, img_in_, time_in_, guidance_in, vector_in_, txt_in_, double_blocks_0_, double_blocks_1_, double_blocks_2_, double_blocks_3_, double_blocks_4_, double_blocks_5_, double_blocks_6_, double_blocks_7_, double_blocks_8_, double_blocks_9_, double_blocks_10_, double_blocks_11_, double_blocks_12_, double_blocks_13_, double_blocks_14_, double_blocks_15_, double_blocks_16_, double_blocks_17_, double_blocks_18_, single_blocks_0_, single_blocks_1_, single_blocks_2_, single_blocks_3_, single_blocks_4_, single_blocks_5_, single_blocks_6_, single_blocks_7_, single_blocks_8_, single_blocks_9_, single_blocks_10_, single_blocks_11_, single_blocks_12_, single_blocks_13_, single_blocks_14_, single_blocks_15_, single_blocks_16_, single_blocks_17_, single_blocks_18_, single_blocks_19_, single_blocks_20_, single_blocks_21_, single_blocks_22_, single_blocks_23_, single_blocks_24_, single_blocks_25_, single_blocks_26_, single_blocks_27_, single_blocks_28_, single_blocks_29_, single_blocks_30_, single_blocks_31_, single_blocks_32_, single_blocks_33_, single_blocks_34_, single_blocks_35_, single_blocks_36_, single_blocks_37_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeFlux1",
 		Inputs: map[string]Value{
@@ -14011,11 +14363,12 @@ func ModelMergeFlux1(gr *Graph, model1 MODEL, model2 MODEL, img_in_, time_in_, g
 			"final_layer.":      Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeLTXV(gr *Graph, model1 MODEL, model2 MODEL, patchify_proj_, adaln_single_, caption_projection_, transformer_blocks_0_, transformer_blocks_1_, transformer_blocks_2_, transformer_blocks_3_, transformer_blocks_4_, transformer_blocks_5_, transformer_blocks_6_, transformer_blocks_7_, transformer_blocks_8_, transformer_blocks_9_, transformer_blocks_10_, transformer_blocks_11_, transformer_blocks_12_, transformer_blocks_13_, transformer_blocks_14_, transformer_blocks_15_, transformer_blocks_16_, transformer_blocks_17_, transformer_blocks_18_, transformer_blocks_19_, transformer_blocks_20_, transformer_blocks_21_, transformer_blocks_22_, transformer_blocks_23_, transformer_blocks_24_, transformer_blocks_25_, transformer_blocks_26_, transformer_blocks_27_, scale_shift_table, proj_out_ float64) (_ *Node, model MODEL) {
+func ModelMergeLTXV(gr *Graph, model1 MODEL, model2 MODEL, patchify_proj_, adaln_single_, caption_projection_, transformer_blocks_0_, transformer_blocks_1_, transformer_blocks_2_, transformer_blocks_3_, transformer_blocks_4_, transformer_blocks_5_, transformer_blocks_6_, transformer_blocks_7_, transformer_blocks_8_, transformer_blocks_9_, transformer_blocks_10_, transformer_blocks_11_, transformer_blocks_12_, transformer_blocks_13_, transformer_blocks_14_, transformer_blocks_15_, transformer_blocks_16_, transformer_blocks_17_, transformer_blocks_18_, transformer_blocks_19_, transformer_blocks_20_, transformer_blocks_21_, transformer_blocks_22_, transformer_blocks_23_, transformer_blocks_24_, transformer_blocks_25_, transformer_blocks_26_, transformer_blocks_27_, scale_shift_table, proj_out_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeLTXV",
 		Inputs: map[string]Value{
@@ -14056,11 +14409,12 @@ func ModelMergeLTXV(gr *Graph, model1 MODEL, model2 MODEL, patchify_proj_, adaln
 			"proj_out.":              Float(proj_out_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeMochiPreview(gr *Graph, model1 MODEL, model2 MODEL, pos_frequencies_, t_embedder_, t5_y_embedder_, t5_yproj_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, blocks_28_, blocks_29_, blocks_30_, blocks_31_, blocks_32_, blocks_33_, blocks_34_, blocks_35_, blocks_36_, blocks_37_, blocks_38_, blocks_39_, blocks_40_, blocks_41_, blocks_42_, blocks_43_, blocks_44_, blocks_45_, blocks_46_, blocks_47_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeMochiPreview(gr *Graph, model1 MODEL, model2 MODEL, pos_frequencies_, t_embedder_, t5_y_embedder_, t5_yproj_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, blocks_28_, blocks_29_, blocks_30_, blocks_31_, blocks_32_, blocks_33_, blocks_34_, blocks_35_, blocks_36_, blocks_37_, blocks_38_, blocks_39_, blocks_40_, blocks_41_, blocks_42_, blocks_43_, blocks_44_, blocks_45_, blocks_46_, blocks_47_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeMochiPreview",
 		Inputs: map[string]Value{
@@ -14121,11 +14475,12 @@ func ModelMergeMochiPreview(gr *Graph, model1 MODEL, model2 MODEL, pos_frequenci
 			"final_layer.":     Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeQwenImage(gr *Graph, model1 MODEL, model2 MODEL, pos_embeds_, img_in_, txt_norm_, txt_in_, time_text_embed_, transformer_blocks_0_, transformer_blocks_1_, transformer_blocks_2_, transformer_blocks_3_, transformer_blocks_4_, transformer_blocks_5_, transformer_blocks_6_, transformer_blocks_7_, transformer_blocks_8_, transformer_blocks_9_, transformer_blocks_10_, transformer_blocks_11_, transformer_blocks_12_, transformer_blocks_13_, transformer_blocks_14_, transformer_blocks_15_, transformer_blocks_16_, transformer_blocks_17_, transformer_blocks_18_, transformer_blocks_19_, transformer_blocks_20_, transformer_blocks_21_, transformer_blocks_22_, transformer_blocks_23_, transformer_blocks_24_, transformer_blocks_25_, transformer_blocks_26_, transformer_blocks_27_, transformer_blocks_28_, transformer_blocks_29_, transformer_blocks_30_, transformer_blocks_31_, transformer_blocks_32_, transformer_blocks_33_, transformer_blocks_34_, transformer_blocks_35_, transformer_blocks_36_, transformer_blocks_37_, transformer_blocks_38_, transformer_blocks_39_, transformer_blocks_40_, transformer_blocks_41_, transformer_blocks_42_, transformer_blocks_43_, transformer_blocks_44_, transformer_blocks_45_, transformer_blocks_46_, transformer_blocks_47_, transformer_blocks_48_, transformer_blocks_49_, transformer_blocks_50_, transformer_blocks_51_, transformer_blocks_52_, transformer_blocks_53_, transformer_blocks_54_, transformer_blocks_55_, transformer_blocks_56_, transformer_blocks_57_, transformer_blocks_58_, transformer_blocks_59_, proj_out_ float64) (_ *Node, model MODEL) {
+func ModelMergeQwenImage(gr *Graph, model1 MODEL, model2 MODEL, pos_embeds_, img_in_, txt_norm_, txt_in_, time_text_embed_, transformer_blocks_0_, transformer_blocks_1_, transformer_blocks_2_, transformer_blocks_3_, transformer_blocks_4_, transformer_blocks_5_, transformer_blocks_6_, transformer_blocks_7_, transformer_blocks_8_, transformer_blocks_9_, transformer_blocks_10_, transformer_blocks_11_, transformer_blocks_12_, transformer_blocks_13_, transformer_blocks_14_, transformer_blocks_15_, transformer_blocks_16_, transformer_blocks_17_, transformer_blocks_18_, transformer_blocks_19_, transformer_blocks_20_, transformer_blocks_21_, transformer_blocks_22_, transformer_blocks_23_, transformer_blocks_24_, transformer_blocks_25_, transformer_blocks_26_, transformer_blocks_27_, transformer_blocks_28_, transformer_blocks_29_, transformer_blocks_30_, transformer_blocks_31_, transformer_blocks_32_, transformer_blocks_33_, transformer_blocks_34_, transformer_blocks_35_, transformer_blocks_36_, transformer_blocks_37_, transformer_blocks_38_, transformer_blocks_39_, transformer_blocks_40_, transformer_blocks_41_, transformer_blocks_42_, transformer_blocks_43_, transformer_blocks_44_, transformer_blocks_45_, transformer_blocks_46_, transformer_blocks_47_, transformer_blocks_48_, transformer_blocks_49_, transformer_blocks_50_, transformer_blocks_51_, transformer_blocks_52_, transformer_blocks_53_, transformer_blocks_54_, transformer_blocks_55_, transformer_blocks_56_, transformer_blocks_57_, transformer_blocks_58_, transformer_blocks_59_, proj_out_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeQwenImage",
 		Inputs: map[string]Value{
@@ -14199,11 +14554,12 @@ func ModelMergeQwenImage(gr *Graph, model1 MODEL, model2 MODEL, pos_embeds_, img
 			"proj_out.":              Float(proj_out_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeSD1(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb_, input_blocks_0_, input_blocks_1_, input_blocks_2_, input_blocks_3_, input_blocks_4_, input_blocks_5_, input_blocks_6_, input_blocks_7_, input_blocks_8_, input_blocks_9_, input_blocks_10_, input_blocks_11_, middle_block_0_, middle_block_1_, middle_block_2_, output_blocks_0_, output_blocks_1_, output_blocks_2_, output_blocks_3_, output_blocks_4_, output_blocks_5_, output_blocks_6_, output_blocks_7_, output_blocks_8_, output_blocks_9_, output_blocks_10_, output_blocks_11_, out_ float64) (_ *Node, model MODEL) {
+func ModelMergeSD1(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb_, input_blocks_0_, input_blocks_1_, input_blocks_2_, input_blocks_3_, input_blocks_4_, input_blocks_5_, input_blocks_6_, input_blocks_7_, input_blocks_8_, input_blocks_9_, input_blocks_10_, input_blocks_11_, middle_block_0_, middle_block_1_, middle_block_2_, output_blocks_0_, output_blocks_1_, output_blocks_2_, output_blocks_3_, output_blocks_4_, output_blocks_5_, output_blocks_6_, output_blocks_7_, output_blocks_8_, output_blocks_9_, output_blocks_10_, output_blocks_11_, out_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeSD1",
 		Inputs: map[string]Value{
@@ -14241,11 +14597,12 @@ func ModelMergeSD1(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb
 			"out.":              Float(out_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeSD2(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb_, input_blocks_0_, input_blocks_1_, input_blocks_2_, input_blocks_3_, input_blocks_4_, input_blocks_5_, input_blocks_6_, input_blocks_7_, input_blocks_8_, input_blocks_9_, input_blocks_10_, input_blocks_11_, middle_block_0_, middle_block_1_, middle_block_2_, output_blocks_0_, output_blocks_1_, output_blocks_2_, output_blocks_3_, output_blocks_4_, output_blocks_5_, output_blocks_6_, output_blocks_7_, output_blocks_8_, output_blocks_9_, output_blocks_10_, output_blocks_11_, out_ float64) (_ *Node, model MODEL) {
+func ModelMergeSD2(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb_, input_blocks_0_, input_blocks_1_, input_blocks_2_, input_blocks_3_, input_blocks_4_, input_blocks_5_, input_blocks_6_, input_blocks_7_, input_blocks_8_, input_blocks_9_, input_blocks_10_, input_blocks_11_, middle_block_0_, middle_block_1_, middle_block_2_, output_blocks_0_, output_blocks_1_, output_blocks_2_, output_blocks_3_, output_blocks_4_, output_blocks_5_, output_blocks_6_, output_blocks_7_, output_blocks_8_, output_blocks_9_, output_blocks_10_, output_blocks_11_, out_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeSD2",
 		Inputs: map[string]Value{
@@ -14283,11 +14640,12 @@ func ModelMergeSD2(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb
 			"out.":              Float(out_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeSD35_Large(gr *Graph, model1 MODEL, model2 MODEL, pos_embed_, x_embedder_, context_embedder_, y_embedder_, t_embedder_, joint_blocks_0_, joint_blocks_1_, joint_blocks_2_, joint_blocks_3_, joint_blocks_4_, joint_blocks_5_, joint_blocks_6_, joint_blocks_7_, joint_blocks_8_, joint_blocks_9_, joint_blocks_10_, joint_blocks_11_, joint_blocks_12_, joint_blocks_13_, joint_blocks_14_, joint_blocks_15_, joint_blocks_16_, joint_blocks_17_, joint_blocks_18_, joint_blocks_19_, joint_blocks_20_, joint_blocks_21_, joint_blocks_22_, joint_blocks_23_, joint_blocks_24_, joint_blocks_25_, joint_blocks_26_, joint_blocks_27_, joint_blocks_28_, joint_blocks_29_, joint_blocks_30_, joint_blocks_31_, joint_blocks_32_, joint_blocks_33_, joint_blocks_34_, joint_blocks_35_, joint_blocks_36_, joint_blocks_37_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeSD35_Large(gr *Graph, model1 MODEL, model2 MODEL, pos_embed_, x_embedder_, context_embedder_, y_embedder_, t_embedder_, joint_blocks_0_, joint_blocks_1_, joint_blocks_2_, joint_blocks_3_, joint_blocks_4_, joint_blocks_5_, joint_blocks_6_, joint_blocks_7_, joint_blocks_8_, joint_blocks_9_, joint_blocks_10_, joint_blocks_11_, joint_blocks_12_, joint_blocks_13_, joint_blocks_14_, joint_blocks_15_, joint_blocks_16_, joint_blocks_17_, joint_blocks_18_, joint_blocks_19_, joint_blocks_20_, joint_blocks_21_, joint_blocks_22_, joint_blocks_23_, joint_blocks_24_, joint_blocks_25_, joint_blocks_26_, joint_blocks_27_, joint_blocks_28_, joint_blocks_29_, joint_blocks_30_, joint_blocks_31_, joint_blocks_32_, joint_blocks_33_, joint_blocks_34_, joint_blocks_35_, joint_blocks_36_, joint_blocks_37_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeSD35_Large",
 		Inputs: map[string]Value{
@@ -14339,11 +14697,12 @@ func ModelMergeSD35_Large(gr *Graph, model1 MODEL, model2 MODEL, pos_embed_, x_e
 			"final_layer.":      Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeSD3_2B(gr *Graph, model1 MODEL, model2 MODEL, pos_embed_, x_embedder_, context_embedder_, y_embedder_, t_embedder_, joint_blocks_0_, joint_blocks_1_, joint_blocks_2_, joint_blocks_3_, joint_blocks_4_, joint_blocks_5_, joint_blocks_6_, joint_blocks_7_, joint_blocks_8_, joint_blocks_9_, joint_blocks_10_, joint_blocks_11_, joint_blocks_12_, joint_blocks_13_, joint_blocks_14_, joint_blocks_15_, joint_blocks_16_, joint_blocks_17_, joint_blocks_18_, joint_blocks_19_, joint_blocks_20_, joint_blocks_21_, joint_blocks_22_, joint_blocks_23_, final_layer_ float64) (_ *Node, model MODEL) {
+func ModelMergeSD3_2B(gr *Graph, model1 MODEL, model2 MODEL, pos_embed_, x_embedder_, context_embedder_, y_embedder_, t_embedder_, joint_blocks_0_, joint_blocks_1_, joint_blocks_2_, joint_blocks_3_, joint_blocks_4_, joint_blocks_5_, joint_blocks_6_, joint_blocks_7_, joint_blocks_8_, joint_blocks_9_, joint_blocks_10_, joint_blocks_11_, joint_blocks_12_, joint_blocks_13_, joint_blocks_14_, joint_blocks_15_, joint_blocks_16_, joint_blocks_17_, joint_blocks_18_, joint_blocks_19_, joint_blocks_20_, joint_blocks_21_, joint_blocks_22_, joint_blocks_23_, final_layer_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeSD3_2B",
 		Inputs: map[string]Value{
@@ -14381,11 +14740,12 @@ func ModelMergeSD3_2B(gr *Graph, model1 MODEL, model2 MODEL, pos_embed_, x_embed
 			"final_layer.":      Float(final_layer_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeSDXL(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb_, input_blocks_0, input_blocks_1, input_blocks_2, input_blocks_3, input_blocks_4, input_blocks_5, input_blocks_6, input_blocks_7, input_blocks_8, middle_block_0, middle_block_1, middle_block_2, output_blocks_0, output_blocks_1, output_blocks_2, output_blocks_3, output_blocks_4, output_blocks_5, output_blocks_6, output_blocks_7, output_blocks_8, out_ float64) (_ *Node, model MODEL) {
+func ModelMergeSDXL(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_emb_, input_blocks_0, input_blocks_1, input_blocks_2, input_blocks_3, input_blocks_4, input_blocks_5, input_blocks_6, input_blocks_7, input_blocks_8, middle_block_0, middle_block_1, middle_block_2, output_blocks_0, output_blocks_1, output_blocks_2, output_blocks_3, output_blocks_4, output_blocks_5, output_blocks_6, output_blocks_7, output_blocks_8, out_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeSDXL",
 		Inputs: map[string]Value{
@@ -14417,11 +14777,12 @@ func ModelMergeSDXL(gr *Graph, model1 MODEL, model2 MODEL, time_embed_, label_em
 			"out.":            Float(out_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeSimple(gr *Graph, model1 MODEL, model2 MODEL, ratio float64) (_ *Node, model MODEL) {
+func ModelMergeSimple(gr *Graph, model1 MODEL, model2 MODEL, ratio float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeSimple",
 		Inputs: map[string]Value{
@@ -14430,11 +14791,12 @@ func ModelMergeSimple(gr *Graph, model1 MODEL, model2 MODEL, ratio float64) (_ *
 			"ratio":  Float(ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeSubtract(gr *Graph, model1 MODEL, model2 MODEL, multiplier float64) (_ *Node, model MODEL) {
+func ModelMergeSubtract(gr *Graph, model1 MODEL, model2 MODEL, multiplier float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeSubtract",
 		Inputs: map[string]Value{
@@ -14443,11 +14805,12 @@ func ModelMergeSubtract(gr *Graph, model1 MODEL, model2 MODEL, multiplier float6
 			"multiplier": Float(multiplier),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelMergeWAN2_1(gr *Graph, model1 MODEL, model2 MODEL, patch_embedding_, time_embedding_, time_projection_, text_embedding_, img_emb_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, blocks_28_, blocks_29_, blocks_30_, blocks_31_, blocks_32_, blocks_33_, blocks_34_, blocks_35_, blocks_36_, blocks_37_, blocks_38_, blocks_39_, head_ float64) (_ *Node, model MODEL) {
+func ModelMergeWAN2_1(gr *Graph, model1 MODEL, model2 MODEL, patch_embedding_, time_embedding_, time_projection_, text_embedding_, img_emb_, blocks_0_, blocks_1_, blocks_2_, blocks_3_, blocks_4_, blocks_5_, blocks_6_, blocks_7_, blocks_8_, blocks_9_, blocks_10_, blocks_11_, blocks_12_, blocks_13_, blocks_14_, blocks_15_, blocks_16_, blocks_17_, blocks_18_, blocks_19_, blocks_20_, blocks_21_, blocks_22_, blocks_23_, blocks_24_, blocks_25_, blocks_26_, blocks_27_, blocks_28_, blocks_29_, blocks_30_, blocks_31_, blocks_32_, blocks_33_, blocks_34_, blocks_35_, blocks_36_, blocks_37_, blocks_38_, blocks_39_, head_ float64, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "ModelMergeWAN2_1",
 		Inputs: map[string]Value{
@@ -14501,22 +14864,24 @@ func ModelMergeWAN2_1(gr *Graph, model1 MODEL, model2 MODEL, patch_embedding_, t
 			"head.":            Float(head_),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelPatchLoader(gr *Graph, name string) (_ *Node, model_patch MODEL_PATCH) {
+func ModelPatchLoader(gr *Graph, name string, opts ...NodeOption) (_ *Node, model_patch MODEL_PATCH) {
 	nd := &Node{
 		Class: "ModelPatchLoader",
 		Inputs: map[string]Value{
 			"name": String(name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL_PATCH{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingAuraFlow(gr *Graph, model MODEL, shift float64) (_ *Node, out_model MODEL) {
+func ModelSamplingAuraFlow(gr *Graph, model MODEL, shift float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingAuraFlow",
 		Inputs: map[string]Value{
@@ -14524,11 +14889,12 @@ func ModelSamplingAuraFlow(gr *Graph, model MODEL, shift float64) (_ *Node, out_
 			"shift": Float(shift),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingContinuousEDM(gr *Graph, model MODEL, sampling string, sigma_max, sigma_min float64) (_ *Node, out_model MODEL) {
+func ModelSamplingContinuousEDM(gr *Graph, model MODEL, sampling string, sigma_max, sigma_min float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingContinuousEDM",
 		Inputs: map[string]Value{
@@ -14538,11 +14904,12 @@ func ModelSamplingContinuousEDM(gr *Graph, model MODEL, sampling string, sigma_m
 			"sigma_min": Float(sigma_min),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingContinuousV(gr *Graph, model MODEL, sampling string, sigma_max, sigma_min float64) (_ *Node, out_model MODEL) {
+func ModelSamplingContinuousV(gr *Graph, model MODEL, sampling string, sigma_max, sigma_min float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingContinuousV",
 		Inputs: map[string]Value{
@@ -14552,11 +14919,12 @@ func ModelSamplingContinuousV(gr *Graph, model MODEL, sampling string, sigma_max
 			"sigma_min": Float(sigma_min),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingDiscrete(gr *Graph, model MODEL, sampling string, zsnr bool) (_ *Node, out_model MODEL) {
+func ModelSamplingDiscrete(gr *Graph, model MODEL, sampling string, zsnr bool, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingDiscrete",
 		Inputs: map[string]Value{
@@ -14565,11 +14933,12 @@ func ModelSamplingDiscrete(gr *Graph, model MODEL, sampling string, zsnr bool) (
 			"zsnr":     Bool(zsnr),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingFlux(gr *Graph, model MODEL, max_shift, base_shift float64, width, height int) (_ *Node, out_model MODEL) {
+func ModelSamplingFlux(gr *Graph, model MODEL, max_shift, base_shift float64, width, height int, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingFlux",
 		Inputs: map[string]Value{
@@ -14580,11 +14949,12 @@ func ModelSamplingFlux(gr *Graph, model MODEL, max_shift, base_shift float64, wi
 			"height":     Int(height),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingLTXV(gr *Graph, model MODEL, latent LATENT, max_shift, base_shift float64) (_ *Node, out_model MODEL) {
+func ModelSamplingLTXV(gr *Graph, model MODEL, latent LATENT, max_shift, base_shift float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingLTXV",
 		Inputs: map[string]Value{
@@ -14593,11 +14963,12 @@ func ModelSamplingLTXV(gr *Graph, model MODEL, latent LATENT, max_shift, base_sh
 			"base_shift": Float(base_shift),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingSD3(gr *Graph, model MODEL, shift float64) (_ *Node, out_model MODEL) {
+func ModelSamplingSD3(gr *Graph, model MODEL, shift float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingSD3",
 		Inputs: map[string]Value{
@@ -14605,11 +14976,12 @@ func ModelSamplingSD3(gr *Graph, model MODEL, shift float64) (_ *Node, out_model
 			"shift": Float(shift),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSamplingStableCascade(gr *Graph, model MODEL, shift float64) (_ *Node, out_model MODEL) {
+func ModelSamplingStableCascade(gr *Graph, model MODEL, shift float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ModelSamplingStableCascade",
 		Inputs: map[string]Value{
@@ -14617,11 +14989,12 @@ func ModelSamplingStableCascade(gr *Graph, model MODEL, shift float64) (_ *Node,
 			"shift": Float(shift),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ModelSave(gr *Graph, model MODEL, filename_prefix string) (_ *Node) {
+func ModelSave(gr *Graph, model MODEL, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "ModelSave",
 		Inputs: map[string]Value{
@@ -14629,12 +15002,13 @@ func ModelSave(gr *Graph, model MODEL, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // MoonvalleyImg2VideoNode - Moonvalley Marey Image to Video
-func MoonvalleyImg2VideoNode(gr *Graph, image IMAGE, prompt, negative_prompt string, resolution string, prompt_adherence float64, seed, steps int) (_ *Node, video VIDEO) {
+func MoonvalleyImg2VideoNode(gr *Graph, image IMAGE, prompt, negative_prompt string, resolution string, prompt_adherence float64, seed, steps int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "MoonvalleyImg2VideoNode",
 		Inputs: map[string]Value{
@@ -14647,12 +15021,13 @@ func MoonvalleyImg2VideoNode(gr *Graph, image IMAGE, prompt, negative_prompt str
 			"steps":            Int(steps),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // MoonvalleyTxt2VideoNode - Moonvalley Marey Text to Video
-func MoonvalleyTxt2VideoNode(gr *Graph, prompt, negative_prompt string, resolution string, prompt_adherence float64, seed, steps int) (_ *Node, video VIDEO) {
+func MoonvalleyTxt2VideoNode(gr *Graph, prompt, negative_prompt string, resolution string, prompt_adherence float64, seed, steps int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "MoonvalleyTxt2VideoNode",
 		Inputs: map[string]Value{
@@ -14664,12 +15039,13 @@ func MoonvalleyTxt2VideoNode(gr *Graph, prompt, negative_prompt string, resoluti
 			"steps":            Int(steps),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // MoonvalleyVideo2VideoNode - Moonvalley Marey Video to Video
-func MoonvalleyVideo2VideoNode(gr *Graph, video VIDEO, prompt, negative_prompt string, seed int, steps int, control_type string, motion_intensity int) (_ *Node, out_video VIDEO) {
+func MoonvalleyVideo2VideoNode(gr *Graph, video VIDEO, prompt, negative_prompt string, seed int, steps int, control_type string, motion_intensity int, opts ...NodeOption) (_ *Node, out_video VIDEO) {
 	nd := &Node{
 		Class: "MoonvalleyVideo2VideoNode",
 		Inputs: map[string]Value{
@@ -14680,12 +15056,13 @@ func MoonvalleyVideo2VideoNode(gr *Graph, video VIDEO, prompt, negative_prompt s
 			"steps":           Int(steps),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // Morphology - ImageMorphology
-func Morphology(gr *Graph, image IMAGE, operation string, kernel_size int) (_ *Node, out_image IMAGE) {
+func Morphology(gr *Graph, image IMAGE, operation string, kernel_size int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "Morphology",
 		Inputs: map[string]Value{
@@ -14694,12 +15071,13 @@ func Morphology(gr *Graph, image IMAGE, operation string, kernel_size int) (_ *N
 			"kernel_size": Int(kernel_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // NormalizeImages - Normalize Images
-func NormalizeImages(gr *Graph, images IMAGE, mean, std float64) (_ *Node, out_images IMAGE) {
+func NormalizeImages(gr *Graph, images IMAGE, mean, std float64, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "NormalizeImages",
 		Inputs: map[string]Value{
@@ -14708,11 +15086,12 @@ func NormalizeImages(gr *Graph, images IMAGE, mean, std float64) (_ *Node, out_i
 			"std":    Float(std),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func NormalizeVideoLatentStart(gr *Graph, latent LATENT, start_frame_count, reference_frame_count int) (_ *Node, out_latent LATENT) {
+func NormalizeVideoLatentStart(gr *Graph, latent LATENT, start_frame_count, reference_frame_count int, opts ...NodeOption) (_ *Node, out_latent LATENT) {
 	nd := &Node{
 		Class: "NormalizeVideoLatentStart",
 		Inputs: map[string]Value{
@@ -14721,24 +15100,26 @@ func NormalizeVideoLatentStart(gr *Graph, latent LATENT, start_frame_count, refe
 			"reference_frame_count": Int(reference_frame_count),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // OpenAIChatConfig - OpenAI ChatGPT Advanced Options
-func OpenAIChatConfig(gr *Graph, truncation string, max_output_tokens int, instructions string) (_ *Node, openai_chat_config OPENAI_CHAT_CONFIG) {
+func OpenAIChatConfig(gr *Graph, truncation string, max_output_tokens int, instructions string, opts ...NodeOption) (_ *Node, openai_chat_config OPENAI_CHAT_CONFIG) {
 	nd := &Node{
 		Class: "OpenAIChatConfig",
 		Inputs: map[string]Value{
 			"truncation": String(truncation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, OPENAI_CHAT_CONFIG{NodeID: id, OutPort: 0}
 }
 
 // OpenAIChatNode - OpenAI ChatGPT
-func OpenAIChatNode(gr *Graph, images IMAGE, files OPENAI_INPUT_FILES, advanced_options OPENAI_CHAT_CONFIG, prompt string, persist_context bool, model string) (_ *Node, str STRING) {
+func OpenAIChatNode(gr *Graph, images IMAGE, files OPENAI_INPUT_FILES, advanced_options OPENAI_CHAT_CONFIG, prompt string, persist_context bool, model string, opts ...NodeOption) (_ *Node, str STRING) {
 	nd := &Node{
 		Class: "OpenAIChatNode",
 		Inputs: map[string]Value{
@@ -14747,60 +15128,65 @@ func OpenAIChatNode(gr *Graph, images IMAGE, files OPENAI_INPUT_FILES, advanced_
 			"model":           String(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // OpenAIDalle2 - OpenAI DALL·E 2
-func OpenAIDalle2(gr *Graph, image IMAGE, mask MASK, prompt string, seed int, size string, n int) (_ *Node, out_image IMAGE) {
+func OpenAIDalle2(gr *Graph, image IMAGE, mask MASK, prompt string, seed int, size string, n int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "OpenAIDalle2",
 		Inputs: map[string]Value{
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // OpenAIDalle3 - OpenAI DALL·E 3
-func OpenAIDalle3(gr *Graph, prompt string, seed int, quality, style, size string) (_ *Node, image IMAGE) {
+func OpenAIDalle3(gr *Graph, prompt string, seed int, quality, style, size string, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "OpenAIDalle3",
 		Inputs: map[string]Value{
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // OpenAIGPTImage1 - OpenAI GPT Image 1
-func OpenAIGPTImage1(gr *Graph, image IMAGE, mask MASK, prompt string, seed int, quality, background, size string, n int, model string) (_ *Node, out_image IMAGE) {
+func OpenAIGPTImage1(gr *Graph, image IMAGE, mask MASK, prompt string, seed int, quality, background, size string, n int, model string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "OpenAIGPTImage1",
 		Inputs: map[string]Value{
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // OpenAIInputFiles - OpenAI ChatGPT Input Files
-func OpenAIInputFiles(gr *Graph, openai_input_files OPENAI_INPUT_FILES, file string) (_ *Node, out_openai_input_files OPENAI_INPUT_FILES) {
+func OpenAIInputFiles(gr *Graph, openai_input_files OPENAI_INPUT_FILES, file string, opts ...NodeOption) (_ *Node, out_openai_input_files OPENAI_INPUT_FILES) {
 	nd := &Node{
 		Class: "OpenAIInputFiles",
 		Inputs: map[string]Value{
 			"file": String(file),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, OPENAI_INPUT_FILES{NodeID: id, OutPort: 0}
 }
 
 // OpenAIVideoSora2 - OpenAI Sora - Video
-func OpenAIVideoSora2(gr *Graph, image IMAGE, model string, prompt string, size, duration string, seed int) (_ *Node, video VIDEO) {
+func OpenAIVideoSora2(gr *Graph, image IMAGE, model string, prompt string, size, duration string, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "OpenAIVideoSora2",
 		Inputs: map[string]Value{
@@ -14810,11 +15196,12 @@ func OpenAIVideoSora2(gr *Graph, image IMAGE, model string, prompt string, size,
 			"duration": String(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func OptimalStepsScheduler(gr *Graph, model_type string, steps int, denoise float64) (_ *Node, sigmas SIGMAS) {
+func OptimalStepsScheduler(gr *Graph, model_type string, steps int, denoise float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "OptimalStepsScheduler",
 		Inputs: map[string]Value{
@@ -14823,12 +15210,13 @@ func OptimalStepsScheduler(gr *Graph, model_type string, steps int, denoise floa
 			"denoise":    Float(denoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // PairConditioningCombine - Cond Pair Combine
-func PairConditioningCombine(gr *Graph, positive_a CONDITIONING, negative_a CONDITIONING, positive_b CONDITIONING, negative_b CONDITIONING) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
+func PairConditioningCombine(gr *Graph, positive_a CONDITIONING, negative_a CONDITIONING, positive_b CONDITIONING, negative_b CONDITIONING, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
 	nd := &Node{
 		Class: "PairConditioningCombine",
 		Inputs: map[string]Value{
@@ -14838,12 +15226,13 @@ func PairConditioningCombine(gr *Graph, positive_a CONDITIONING, negative_a COND
 			"negative_B": Link(negative_b),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // PairConditioningSetDefaultCombine - Cond Pair Set Default Combine
-func PairConditioningSetDefaultCombine(gr *Graph, positive CONDITIONING, negative CONDITIONING, positive_default CONDITIONING, negative_default CONDITIONING, hooks HOOKS) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
+func PairConditioningSetDefaultCombine(gr *Graph, positive CONDITIONING, negative CONDITIONING, positive_default CONDITIONING, negative_default CONDITIONING, hooks HOOKS, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
 	nd := &Node{
 		Class: "PairConditioningSetDefaultCombine",
 		Inputs: map[string]Value{
@@ -14853,12 +15242,13 @@ func PairConditioningSetDefaultCombine(gr *Graph, positive CONDITIONING, negativ
 			"negative_DEFAULT": Link(negative_default),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // PairConditioningSetProperties - Cond Pair Set Props
-func PairConditioningSetProperties(gr *Graph, positive_new CONDITIONING, negative_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
+func PairConditioningSetProperties(gr *Graph, positive_new CONDITIONING, negative_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING) {
 	nd := &Node{
 		Class: "PairConditioningSetProperties",
 		Inputs: map[string]Value{
@@ -14868,12 +15258,13 @@ func PairConditioningSetProperties(gr *Graph, positive_new CONDITIONING, negativ
 			"set_cond_area": String(set_cond_area),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // PairConditioningSetPropertiesAndCombine - Cond Pair Set Props Combine
-func PairConditioningSetPropertiesAndCombine(gr *Graph, positive CONDITIONING, negative CONDITIONING, positive_new CONDITIONING, negative_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
+func PairConditioningSetPropertiesAndCombine(gr *Graph, positive CONDITIONING, negative CONDITIONING, positive_new CONDITIONING, negative_new CONDITIONING, mask MASK, hooks HOOKS, timesteps TIMESTEPS_RANGE, strength float64, set_cond_area string, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING) {
 	nd := &Node{
 		Class: "PairConditioningSetPropertiesAndCombine",
 		Inputs: map[string]Value{
@@ -14885,12 +15276,13 @@ func PairConditioningSetPropertiesAndCombine(gr *Graph, positive CONDITIONING, n
 			"set_cond_area": String(set_cond_area),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // PatchModelAddDownscale - PatchModelAddDownscale (Kohya Deep Shrink)
-func PatchModelAddDownscale(gr *Graph, model MODEL, block_number int, downscale_factor, start_percent, end_percent float64, downscale_after_skip bool, downscale_method, upscale_method string) (_ *Node, out_model MODEL) {
+func PatchModelAddDownscale(gr *Graph, model MODEL, block_number int, downscale_factor, start_percent, end_percent float64, downscale_after_skip bool, downscale_method, upscale_method string, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "PatchModelAddDownscale",
 		Inputs: map[string]Value{
@@ -14904,12 +15296,13 @@ func PatchModelAddDownscale(gr *Graph, model MODEL, block_number int, downscale_
 			"upscale_method":       String(upscale_method),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // PerpNeg - Perp-Neg (DEPRECATED by PerpNegGuider)
-func PerpNeg(gr *Graph, model MODEL, empty_conditioning CONDITIONING, neg_scale float64) (_ *Node, out_model MODEL) {
+func PerpNeg(gr *Graph, model MODEL, empty_conditioning CONDITIONING, neg_scale float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "PerpNeg",
 		Inputs: map[string]Value{
@@ -14918,11 +15311,12 @@ func PerpNeg(gr *Graph, model MODEL, empty_conditioning CONDITIONING, neg_scale
 			"neg_scale":          Float(neg_scale),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func PerpNegGuider(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, empty_conditioning CONDITIONING, cfg, neg_scale float64) (_ *Node, guider GUIDER) {
+func PerpNegGuider(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, empty_conditioning CONDITIONING, cfg, neg_scale float64, opts ...NodeOption) (_ *Node, guider GUIDER) {
 	nd := &Node{
 		Class: "PerpNegGuider",
 		Inputs: map[string]Value{
@@ -14934,11 +15328,12 @@ func PerpNegGuider(gr *Graph, model MODEL, positive CONDITIONING, negative CONDI
 			"neg_scale":          Float(neg_scale),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, GUIDER{NodeID: id, OutPort: 0}
 }
 
-func PerturbedAttentionGuidance(gr *Graph, model MODEL, scale float64) (_ *Node, out_model MODEL) {
+func PerturbedAttentionGuidance(gr *Graph, model MODEL, scale float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "PerturbedAttentionGuidance",
 		Inputs: map[string]Value{
@@ -14946,11 +15341,12 @@ func PerturbedAttentionGuidance(gr *Graph, model MODEL, scale float64) (_ *Node,
 			"scale": Float(scale),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func PhotoMakerEncode(gr *Graph, photomaker PHOTOMAKER, image IMAGE, clip CLIP, text string) (_ *Node, conditioning CONDITIONING) {
+func PhotoMakerEncode(gr *Graph, photomaker PHOTOMAKER, image IMAGE, clip CLIP, text string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "PhotoMakerEncode",
 		Inputs: map[string]Value{
@@ -14960,23 +15356,25 @@ func PhotoMakerEncode(gr *Graph, photomaker PHOTOMAKER, image IMAGE, clip CLIP,
 			"text":       String(text),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func PhotoMakerLoader(gr *Graph, photomaker_model_name string) (_ *Node, photomaker PHOTOMAKER) {
+func PhotoMakerLoader(gr *Graph, photomaker_model_name string, opts ...NodeOption) (_ *Node, photomaker PHOTOMAKER) {
 	nd := &Node{
 		Class: "PhotoMakerLoader",
 		Inputs: map[string]Value{
 			"photomaker_model_name": String(photomaker_model_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, PHOTOMAKER{NodeID: id, OutPort: 0}
 }
 
 // PixverseImageToVideoNode - PixVerse Image to Video
-func PixverseImageToVideoNode(gr *Graph, image IMAGE, pixverse_template PIXVERSE_TEMPLATE, prompt string, quality, duration_seconds, motion_mode string, seed int, negative_prompt string) (_ *Node, video VIDEO) {
+func PixverseImageToVideoNode(gr *Graph, image IMAGE, pixverse_template PIXVERSE_TEMPLATE, prompt string, quality, duration_seconds, motion_mode string, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "PixverseImageToVideoNode",
 		Inputs: map[string]Value{
@@ -14988,24 +15386,26 @@ func PixverseImageToVideoNode(gr *Graph, image IMAGE, pixverse_template PIXVERSE
 			"seed":             Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // PixverseTemplateNode - PixVerse Template
-func PixverseTemplateNode(gr *Graph, template string) (_ *Node, pixverse_template PIXVERSE_TEMPLATE) {
+func PixverseTemplateNode(gr *Graph, template string, opts ...NodeOption) (_ *Node, pixverse_template PIXVERSE_TEMPLATE) {
 	nd := &Node{
 		Class: "PixverseTemplateNode",
 		Inputs: map[string]Value{
 			"template": String(template),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, PIXVERSE_TEMPLATE{NodeID: id, OutPort: 0}
 }
 
 // PixverseTextToVideoNode - PixVerse Text to Video
-func PixverseTextToVideoNode(gr *Graph, pixverse_template PIXVERSE_TEMPLATE, prompt string, aspect_ratio, quality, duration_seconds, motion_mode string, seed int, negative_prompt string) (_ *Node, video VIDEO) {
+func PixverseTextToVideoNode(gr *Graph, pixverse_template PIXVERSE_TEMPLATE, prompt string, aspect_ratio, quality, duration_seconds, motion_mode string, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "PixverseTextToVideoNode",
 		Inputs: map[string]Value{
@@ -15017,12 +15417,13 @@ func PixverseTextToVideoNode(gr *Graph, pixverse_template PIXVERSE_TEMPLATE, pro
 			"seed":             Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // PixverseTransitionVideoNode - PixVerse Transition Video
-func PixverseTransitionVideoNode(gr *Graph, first_frame IMAGE, last_frame IMAGE, prompt string, quality, duration_seconds, motion_mode string, seed int, negative_prompt string) (_ *Node, video VIDEO) {
+func PixverseTransitionVideoNode(gr *Graph, first_frame IMAGE, last_frame IMAGE, prompt string, quality, duration_seconds, motion_mode string, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "PixverseTransitionVideoNode",
 		Inputs: map[string]Value{
@@ -15035,11 +15436,12 @@ func PixverseTransitionVideoNode(gr *Graph, first_frame IMAGE, last_frame IMAGE,
 			"seed":             Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func PolyexponentialScheduler(gr *Graph, steps int, sigma_max, sigma_min, rho float64) (_ *Node, sigmas SIGMAS) {
+func PolyexponentialScheduler(gr *Graph, steps int, sigma_max, sigma_min, rho float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "PolyexponentialScheduler",
 		Inputs: map[string]Value{
@@ -15049,12 +15451,13 @@ func PolyexponentialScheduler(gr *Graph, steps int, sigma_max, sigma_min, rho fl
 			"rho":       Float(rho),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // PorterDuffImageComposite - Porter-Duff Image Composite
-func PorterDuffImageComposite(gr *Graph, source IMAGE, source_alpha MASK, destination IMAGE, destination_alpha MASK, mode string) (_ *Node, image IMAGE, mask MASK) {
+func PorterDuffImageComposite(gr *Graph, source IMAGE, source_alpha MASK, destination IMAGE, destination_alpha MASK, mode string, opts ...NodeOption) (_ *Node, image IMAGE, mask MASK) {
 	nd := &Node{
 		Class: "PorterDuffImageComposite",
 		Inputs: map[string]Value{
@@ -15065,119 +15468,129 @@ func PorterDuffImageComposite(gr *Graph, source IMAGE, source_alpha MASK, destin
 			"mode":              String(mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, MASK{NodeID: id, OutPort: 1}
 }
 
 // Preview3D - Preview 3D & Animation
-func Preview3D(gr *Graph, camera_info LOAD3D_CAMERA, bg_image IMAGE, model_file string) (_ *Node) {
+func Preview3D(gr *Graph, camera_info LOAD3D_CAMERA, bg_image IMAGE, model_file string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "Preview3D",
 		Inputs: map[string]Value{
 			"model_file": String(model_file),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // PreviewAny - Preview as Text
-func PreviewAny(gr *Graph, source Link) (_ *Node) {
+func PreviewAny(gr *Graph, source Link, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "PreviewAny",
 		Inputs: map[string]Value{
 			"source": Link(source),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // PreviewAudio - Preview Audio
-func PreviewAudio(gr *Graph, audio AUDIO) (_ *Node) {
+func PreviewAudio(gr *Graph, audio AUDIO, opts ...NodeOption) (_ *Node, _ AudioOutput) {
 	nd := &Node{
 		Class: "PreviewAudio",
 		Inputs: map[string]Value{
 			"audio": Link(audio),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, AudioOutput{Output{NodeID: id}}
 }
 
 // PreviewImage - Preview Image
-func PreviewImage(gr *Graph, images IMAGE) (_ *Node) {
+func PreviewImage(gr *Graph, images IMAGE, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "PreviewImage",
 		Inputs: map[string]Value{
 			"images": Link(images),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
 // PrimitiveBoolean - Boolean
-func PrimitiveBoolean(gr *Graph, value bool) (_ *Node, boolean BOOLEAN) {
+func PrimitiveBoolean(gr *Graph, value bool, opts ...NodeOption) (_ *Node, boolean BOOLEAN) {
 	nd := &Node{
 		Class: "PrimitiveBoolean",
 		Inputs: map[string]Value{
 			"value": Bool(value),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, BOOLEAN{NodeID: id, OutPort: 0}
 }
 
 // PrimitiveFloat - Float
-func PrimitiveFloat(gr *Graph, value float64) (_ *Node, float FLOAT) {
+func PrimitiveFloat(gr *Graph, value float64, opts ...NodeOption) (_ *Node, float FLOAT) {
 	nd := &Node{
 		Class: "PrimitiveFloat",
 		Inputs: map[string]Value{
 			"value": Float(value),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, FLOAT{NodeID: id, OutPort: 0}
 }
 
 // PrimitiveInt - Int
-func PrimitiveInt(gr *Graph, value int) (_ *Node, int INT) {
+func PrimitiveInt(gr *Graph, value int, opts ...NodeOption) (_ *Node, int INT) {
 	nd := &Node{
 		Class: "PrimitiveInt",
 		Inputs: map[string]Value{
 			"value": Int(value),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, INT{NodeID: id, OutPort: 0}
 }
 
 // PrimitiveString - String
-func PrimitiveString(gr *Graph, value string) (_ *Node, str STRING) {
+func PrimitiveString(gr *Graph, value string, opts ...NodeOption) (_ *Node, str STRING) {
 	nd := &Node{
 		Class: "PrimitiveString",
 		Inputs: map[string]Value{
 			"value": String(value),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // PrimitiveStringMultiline - String (Multiline)
-func PrimitiveStringMultiline(gr *Graph, value string) (_ *Node, str STRING) {
+func PrimitiveStringMultiline(gr *Graph, value string, opts ...NodeOption) (_ *Node, str STRING) {
 	nd := &Node{
 		Class: "PrimitiveStringMultiline",
 		Inputs: map[string]Value{
 			"value": String(value),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
-func QuadrupleCLIPLoader(gr *Graph, clip_name1, clip_name2, clip_name3, clip_name4 string) (_ *Node, clip CLIP) {
+func QuadrupleCLIPLoader(gr *Graph, clip_name1, clip_name2, clip_name3, clip_name4 string, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "QuadrupleCLIPLoader",
 		Inputs: map[string]Value{
@@ -15187,11 +15600,12 @@ func QuadrupleCLIPLoader(gr *Graph, clip_name1, clip_name2, clip_name3, clip_nam
 			"clip_name4": String(clip_name4),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func QwenImageDiffsynthControlnet(gr *Graph, model MODEL, model_patch MODEL_PATCH, vae VAE, image IMAGE, mask MASK, strength float64) (_ *Node, out_model MODEL) {
+func QwenImageDiffsynthControlnet(gr *Graph, model MODEL, model_patch MODEL_PATCH, vae VAE, image IMAGE, mask MASK, strength float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "QwenImageDiffsynthControlnet",
 		Inputs: map[string]Value{
@@ -15202,12 +15616,13 @@ func QwenImageDiffsynthControlnet(gr *Graph, model MODEL, model_patch MODEL_PATC
 			"strength":    Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // RandomCropImages - Random Crop Images
-func RandomCropImages(gr *Graph, images IMAGE, width, height, seed int) (_ *Node, out_images IMAGE) {
+func RandomCropImages(gr *Graph, images IMAGE, width, height, seed int, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "RandomCropImages",
 		Inputs: map[string]Value{
@@ -15217,23 +15632,25 @@ func RandomCropImages(gr *Graph, images IMAGE, width, height, seed int) (_ *Node
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func RandomNoise(gr *Graph, noise_seed int) (_ *Node, noise NOISE) {
+func RandomNoise(gr *Graph, noise_seed int, opts ...NodeOption) (_ *Node, noise NOISE) {
 	nd := &Node{
 		Class: "RandomNoise",
 		Inputs: map[string]Value{
 			"noise_seed": Int(noise_seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, NOISE{NodeID: id, OutPort: 0}
 }
 
 // RebatchImages - Rebatch Images
-func RebatchImages(gr *Graph, images IMAGE, batch_size int) (_ *Node, image IMAGE) {
+func RebatchImages(gr *Graph, images IMAGE, batch_size int, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "RebatchImages",
 		Inputs: map[string]Value{
@@ -15241,12 +15658,13 @@ func RebatchImages(gr *Graph, images IMAGE, batch_size int) (_ *Node, image IMAG
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RebatchLatents - Rebatch Latents
-func RebatchLatents(gr *Graph, latents LATENT, batch_size int) (_ *Node, latent LATENT) {
+func RebatchLatents(gr *Graph, latents LATENT, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "RebatchLatents",
 		Inputs: map[string]Value{
@@ -15254,24 +15672,26 @@ func RebatchLatents(gr *Graph, latents LATENT, batch_size int) (_ *Node, latent
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // RecordAudio - Record Audio
-func RecordAudio(gr *Graph, audio AUDIO_RECORD) (_ *Node, out_audio AUDIO) {
+func RecordAudio(gr *Graph, audio AUDIO_RECORD, opts ...NodeOption) (_ *Node, out_audio AUDIO) {
 	nd := &Node{
 		Class: "RecordAudio",
 		Inputs: map[string]Value{
 			"audio": Link(audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // RecraftColorRGB - Recraft Color RGB
-func RecraftColorRGB(gr *Graph, recraft_color RECRAFT_COLOR, r, g, b int) (_ *Node, out_recraft_color RECRAFT_COLOR) {
+func RecraftColorRGB(gr *Graph, recraft_color RECRAFT_COLOR, r, g, b int, opts ...NodeOption) (_ *Node, out_recraft_color RECRAFT_COLOR) {
 	nd := &Node{
 		Class: "RecraftColorRGB",
 		Inputs: map[string]Value{
@@ -15280,46 +15700,50 @@ func RecraftColorRGB(gr *Graph, recraft_color RECRAFT_COLOR, r, g, b int) (_ *No
 			"b": Int(b),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, RECRAFT_COLOR{NodeID: id, OutPort: 0}
 }
 
 // RecraftControls - Recraft Controls
-func RecraftControls(gr *Graph, colors RECRAFT_COLOR, background_color RECRAFT_COLOR) (_ *Node, recraft_controls RECRAFT_CONTROLS) {
+func RecraftControls(gr *Graph, colors RECRAFT_COLOR, background_color RECRAFT_COLOR, opts ...NodeOption) (_ *Node, recraft_controls RECRAFT_CONTROLS) {
 	nd := &Node{
 		Class:  "RecraftControls",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, RECRAFT_CONTROLS{NodeID: id, OutPort: 0}
 }
 
 // RecraftCreativeUpscaleNode - Recraft Creative Upscale Image
-func RecraftCreativeUpscaleNode(gr *Graph, image IMAGE) (_ *Node, out_image IMAGE) {
+func RecraftCreativeUpscaleNode(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "RecraftCreativeUpscaleNode",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RecraftCrispUpscaleNode - Recraft Crisp Upscale Image
-func RecraftCrispUpscaleNode(gr *Graph, image IMAGE) (_ *Node, out_image IMAGE) {
+func RecraftCrispUpscaleNode(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "RecraftCrispUpscaleNode",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RecraftImageInpaintingNode - Recraft Image Inpainting
-func RecraftImageInpaintingNode(gr *Graph, image IMAGE, mask MASK, recraft_style RECRAFT_V3_STYLE, prompt string, n, seed int, negative_prompt string) (_ *Node, out_image IMAGE) {
+func RecraftImageInpaintingNode(gr *Graph, image IMAGE, mask MASK, recraft_style RECRAFT_V3_STYLE, prompt string, n, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "RecraftImageInpaintingNode",
 		Inputs: map[string]Value{
@@ -15330,12 +15754,13 @@ func RecraftImageInpaintingNode(gr *Graph, image IMAGE, mask MASK, recraft_style
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RecraftImageToImageNode - Recraft Image to Image
-func RecraftImageToImageNode(gr *Graph, image IMAGE, recraft_style RECRAFT_V3_STYLE, recraft_controls RECRAFT_CONTROLS, prompt string, n int, strength float64, seed int, negative_prompt string) (_ *Node, out_image IMAGE) {
+func RecraftImageToImageNode(gr *Graph, image IMAGE, recraft_style RECRAFT_V3_STYLE, recraft_controls RECRAFT_CONTROLS, prompt string, n int, strength float64, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "RecraftImageToImageNode",
 		Inputs: map[string]Value{
@@ -15346,24 +15771,26 @@ func RecraftImageToImageNode(gr *Graph, image IMAGE, recraft_style RECRAFT_V3_ST
 			"seed":     Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RecraftRemoveBackgroundNode - Recraft Remove Background
-func RecraftRemoveBackgroundNode(gr *Graph, image IMAGE) (_ *Node, out_image IMAGE, mask MASK) {
+func RecraftRemoveBackgroundNode(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE, mask MASK) {
 	nd := &Node{
 		Class: "RecraftRemoveBackgroundNode",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, MASK{NodeID: id, OutPort: 1}
 }
 
 // RecraftReplaceBackgroundNode - Recraft Replace Background
-func RecraftReplaceBackgroundNode(gr *Graph, image IMAGE, recraft_style RECRAFT_V3_STYLE, prompt string, n, seed int, negative_prompt string) (_ *Node, out_image IMAGE) {
+func RecraftReplaceBackgroundNode(gr *Graph, image IMAGE, recraft_style RECRAFT_V3_STYLE, prompt string, n, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "RecraftReplaceBackgroundNode",
 		Inputs: map[string]Value{
@@ -15373,60 +15800,65 @@ func RecraftReplaceBackgroundNode(gr *Graph, image IMAGE, recraft_style RECRAFT_
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RecraftStyleV3DigitalIllustration - Recraft Style - Digital Illustration
-func RecraftStyleV3DigitalIllustration(gr *Graph, substyle string) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
+func RecraftStyleV3DigitalIllustration(gr *Graph, substyle string, opts ...NodeOption) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
 	nd := &Node{
 		Class: "RecraftStyleV3DigitalIllustration",
 		Inputs: map[string]Value{
 			"substyle": String(substyle),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, RECRAFT_V3_STYLE{NodeID: id, OutPort: 0}
 }
 
 // RecraftStyleV3InfiniteStyleLibrary - Recraft Style - Infinite Style Library
-func RecraftStyleV3InfiniteStyleLibrary(gr *Graph, style_id string) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
+func RecraftStyleV3InfiniteStyleLibrary(gr *Graph, style_id string, opts ...NodeOption) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
 	nd := &Node{
 		Class: "RecraftStyleV3InfiniteStyleLibrary",
 		Inputs: map[string]Value{
 			"style_id": String(style_id),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, RECRAFT_V3_STYLE{NodeID: id, OutPort: 0}
 }
 
 // RecraftStyleV3LogoRaster - Recraft Style - Logo Raster
-func RecraftStyleV3LogoRaster(gr *Graph, substyle string) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
+func RecraftStyleV3LogoRaster(gr *Graph, substyle string, opts ...NodeOption) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
 	nd := &Node{
 		Class: "RecraftStyleV3LogoRaster",
 		Inputs: map[string]Value{
 			"substyle": String(substyle),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, RECRAFT_V3_STYLE{NodeID: id, OutPort: 0}
 }
 
 // RecraftStyleV3RealisticImage - Recraft Style - Realistic Image
-func RecraftStyleV3RealisticImage(gr *Graph, substyle string) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
+func RecraftStyleV3RealisticImage(gr *Graph, substyle string, opts ...NodeOption) (_ *Node, recraft_style RECRAFT_V3_STYLE) {
 	nd := &Node{
 		Class: "RecraftStyleV3RealisticImage",
 		Inputs: map[string]Value{
 			"substyle": String(substyle),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, RECRAFT_V3_STYLE{NodeID: id, OutPort: 0}
 }
 
 // RecraftTextToImageNode - Recraft Text to Image
-func RecraftTextToImageNode(gr *Graph, recraft_style RECRAFT_V3_STYLE, recraft_controls RECRAFT_CONTROLS, prompt string, size string, n, seed int, negative_prompt string) (_ *Node, image IMAGE) {
+func RecraftTextToImageNode(gr *Graph, recraft_style RECRAFT_V3_STYLE, recraft_controls RECRAFT_CONTROLS, prompt string, size string, n, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "RecraftTextToImageNode",
 		Inputs: map[string]Value{
@@ -15436,12 +15868,13 @@ func RecraftTextToImageNode(gr *Graph, recraft_style RECRAFT_V3_STYLE, recraft_c
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RecraftTextToVectorNode - Recraft Text to Vector
-func RecraftTextToVectorNode(gr *Graph, recraft_controls RECRAFT_CONTROLS, prompt string, substyle, size string, n, seed int, negative_prompt string) (_ *Node, svg SVG) {
+func RecraftTextToVectorNode(gr *Graph, recraft_controls RECRAFT_CONTROLS, prompt string, substyle, size string, n, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, svg SVG) {
 	nd := &Node{
 		Class: "RecraftTextToVectorNode",
 		Inputs: map[string]Value{
@@ -15452,35 +15885,38 @@ func RecraftTextToVectorNode(gr *Graph, recraft_controls RECRAFT_CONTROLS, promp
 			"seed":     Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SVG{NodeID: id, OutPort: 0}
 }
 
 // RecraftVectorizeImageNode - Recraft Vectorize Image
-func RecraftVectorizeImageNode(gr *Graph, image IMAGE) (_ *Node, svg SVG) {
+func RecraftVectorizeImageNode(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, svg SVG) {
 	nd := &Node{
 		Class: "RecraftVectorizeImageNode",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SVG{NodeID: id, OutPort: 0}
 }
 
-func ReferenceLatent(gr *Graph, conditioning CONDITIONING, latent LATENT) (_ *Node, out_conditioning CONDITIONING) {
+func ReferenceLatent(gr *Graph, conditioning CONDITIONING, latent LATENT, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ReferenceLatent",
 		Inputs: map[string]Value{
 			"conditioning": Link(conditioning),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // RegexExtract - Regex Extract
-func RegexExtract(gr *Graph, str, regex_pattern string, mode string, case_insensitive, multiline, dotall bool, group_index int) (_ *Node, out_str STRING) {
+func RegexExtract(gr *Graph, str, regex_pattern string, mode string, case_insensitive, multiline, dotall bool, group_index int, opts ...NodeOption) (_ *Node, out_str STRING) {
 	nd := &Node{
 		Class: "RegexExtract",
 		Inputs: map[string]Value{
@@ -15493,12 +15929,13 @@ func RegexExtract(gr *Graph, str, regex_pattern string, mode string, case_insens
 			"group_index":      Int(group_index),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // RegexMatch - Regex Match
-func RegexMatch(gr *Graph, str, regex_pattern string, case_insensitive, multiline, dotall bool) (_ *Node, matches BOOLEAN) {
+func RegexMatch(gr *Graph, str, regex_pattern string, case_insensitive, multiline, dotall bool, opts ...NodeOption) (_ *Node, matches BOOLEAN) {
 	nd := &Node{
 		Class: "RegexMatch",
 		Inputs: map[string]Value{
@@ -15509,12 +15946,13 @@ func RegexMatch(gr *Graph, str, regex_pattern string, case_insensitive, multilin
 			"dotall":           Bool(dotall),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, BOOLEAN{NodeID: id, OutPort: 0}
 }
 
 // RegexReplace - Regex Replace
-func RegexReplace(gr *Graph, str, regex_pattern, replace string, case_insensitive, multiline, dotall bool, count int) (_ *Node, out_str STRING) {
+func RegexReplace(gr *Graph, str, regex_pattern, replace string, case_insensitive, multiline, dotall bool, count int, opts ...NodeOption) (_ *Node, out_str STRING) {
 	nd := &Node{
 		Class: "RegexReplace",
 		Inputs: map[string]Value{
@@ -15523,11 +15961,12 @@ func RegexReplace(gr *Graph, str, regex_pattern, replace string, case_insensitiv
 			"replace":       String(replace),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
-func RenormCFG(gr *Graph, model MODEL, cfg_trunc, renorm_cfg float64) (_ *Node, out_model MODEL) {
+func RenormCFG(gr *Graph, model MODEL, cfg_trunc, renorm_cfg float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "RenormCFG",
 		Inputs: map[string]Value{
@@ -15536,11 +15975,12 @@ func RenormCFG(gr *Graph, model MODEL, cfg_trunc, renorm_cfg float64) (_ *Node,
 			"renorm_cfg": Float(renorm_cfg),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func RepeatImageBatch(gr *Graph, image IMAGE, amount int) (_ *Node, out_image IMAGE) {
+func RepeatImageBatch(gr *Graph, image IMAGE, amount int, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "RepeatImageBatch",
 		Inputs: map[string]Value{
@@ -15548,12 +15988,13 @@ func RepeatImageBatch(gr *Graph, image IMAGE, amount int) (_ *Node, out_image IM
 			"amount": Int(amount),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // RepeatLatentBatch - Repeat Latent Batch
-func RepeatLatentBatch(gr *Graph, samples LATENT, amount int) (_ *Node, latent LATENT) {
+func RepeatLatentBatch(gr *Graph, samples LATENT, amount int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "RepeatLatentBatch",
 		Inputs: map[string]Value{
@@ -15561,12 +16002,13 @@ func RepeatLatentBatch(gr *Graph, samples LATENT, amount int) (_ *Node, latent L
 			"amount":  Int(amount),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // ReplaceText - Replace Text
-func ReplaceText(gr *Graph, texts, find, replace string) (_ *Node, out_texts STRING) {
+func ReplaceText(gr *Graph, texts, find, replace string, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "ReplaceText",
 		Inputs: map[string]Value{
@@ -15575,11 +16017,12 @@ func ReplaceText(gr *Graph, texts, find, replace string) (_ *Node, out_texts STR
 			"replace": String(replace),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
-func ReplaceVideoLatentFrames(gr *Graph, destination LATENT, source LATENT, index int) (_ *Node, latent LATENT) {
+func ReplaceVideoLatentFrames(gr *Graph, destination LATENT, source LATENT, index int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "ReplaceVideoLatentFrames",
 		Inputs: map[string]Value{
@@ -15587,11 +16030,12 @@ func ReplaceVideoLatentFrames(gr *Graph, destination LATENT, source LATENT, inde
 			"index":       Int(index),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func RescaleCFG(gr *Graph, model MODEL, multiplier float64) (_ *Node, out_model MODEL) {
+func RescaleCFG(gr *Graph, model MODEL, multiplier float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "RescaleCFG",
 		Inputs: map[string]Value{
@@ -15599,11 +16043,12 @@ func RescaleCFG(gr *Graph, model MODEL, multiplier float64) (_ *Node, out_model
 			"multiplier": Float(multiplier),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func ResizeAndPadImage(gr *Graph, image IMAGE, target_width, target_height int, padding_color, interpolation string) (_ *Node, out_image IMAGE) {
+func ResizeAndPadImage(gr *Graph, image IMAGE, target_width, target_height int, padding_color, interpolation string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "ResizeAndPadImage",
 		Inputs: map[string]Value{
@@ -15614,12 +16059,13 @@ func ResizeAndPadImage(gr *Graph, image IMAGE, target_width, target_height int,
 			"interpolation": String(interpolation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ResizeImageMaskNode - Resize Image/Mask
-func ResizeImageMaskNode(gr *Graph, input COMFY_MATCHTYPE_V3, resize_type COMFY_DYNAMICCOMBO_V3, scale_method string) (_ *Node, resized COMFY_MATCHTYPE_V3) {
+func ResizeImageMaskNode(gr *Graph, input COMFY_MATCHTYPE_V3, resize_type COMFY_DYNAMICCOMBO_V3, scale_method string, opts ...NodeOption) (_ *Node, resized COMFY_MATCHTYPE_V3) {
 	nd := &Node{
 		Class: "ResizeImageMaskNode",
 		Inputs: map[string]Value{
@@ -15628,12 +16074,13 @@ func ResizeImageMaskNode(gr *Graph, input COMFY_MATCHTYPE_V3, resize_type COMFY_
 			"scale_method": String(scale_method),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, COMFY_MATCHTYPE_V3{NodeID: id, OutPort: 0}
 }
 
 // ResizeImagesByLongerEdge - Resize Images by Longer Edge
-func ResizeImagesByLongerEdge(gr *Graph, images IMAGE, longer_edge int) (_ *Node, out_images IMAGE) {
+func ResizeImagesByLongerEdge(gr *Graph, images IMAGE, longer_edge int, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "ResizeImagesByLongerEdge",
 		Inputs: map[string]Value{
@@ -15641,12 +16088,13 @@ func ResizeImagesByLongerEdge(gr *Graph, images IMAGE, longer_edge int) (_ *Node
 			"longer_edge": Int(longer_edge),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ResizeImagesByShorterEdge - Resize Images by Shorter Edge
-func ResizeImagesByShorterEdge(gr *Graph, images IMAGE, shorter_edge int) (_ *Node, out_images IMAGE) {
+func ResizeImagesByShorterEdge(gr *Graph, images IMAGE, shorter_edge int, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "ResizeImagesByShorterEdge",
 		Inputs: map[string]Value{
@@ -15654,12 +16102,13 @@ func ResizeImagesByShorterEdge(gr *Graph, images IMAGE, shorter_edge int) (_ *No
 			"shorter_edge": Int(shorter_edge),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ResolutionBucket - Resolution Bucket
-func ResolutionBucket(gr *Graph, latents LATENT, conditioning CONDITIONING) (_ *Node, out_latents LATENT, out_conditioning CONDITIONING) {
+func ResolutionBucket(gr *Graph, latents LATENT, conditioning CONDITIONING, opts ...NodeOption) (_ *Node, out_latents LATENT, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "ResolutionBucket",
 		Inputs: map[string]Value{
@@ -15667,24 +16116,26 @@ func ResolutionBucket(gr *Graph, latents LATENT, conditioning CONDITIONING) (_ *
 			"conditioning": Link(conditioning),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}
 }
 
 // Rodin3D_Detail - Rodin 3D Generate - Detail Generate
-func Rodin3D_Detail(gr *Graph, images IMAGE, seed int, material_type, polygon_count string) (_ *Node, _3d_model_path STRING) {
+func Rodin3D_Detail(gr *Graph, images IMAGE, seed int, material_type, polygon_count string, opts ...NodeOption) (_ *Node, _3d_model_path STRING) {
 	nd := &Node{
 		Class: "Rodin3D_Detail",
 		Inputs: map[string]Value{
 			"Images": Link(images),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // Rodin3D_Gen2 - Rodin 3D Generate - Gen-2 Generate
-func Rodin3D_Gen2(gr *Graph, images IMAGE, tapose bool, seed int, material_type, polygon_count string) (_ *Node, _3d_model_path STRING) {
+func Rodin3D_Gen2(gr *Graph, images IMAGE, tapose bool, seed int, material_type, polygon_count string, opts ...NodeOption) (_ *Node, _3d_model_path STRING) {
 	nd := &Node{
 		Class: "Rodin3D_Gen2",
 		Inputs: map[string]Value{
@@ -15692,48 +16143,52 @@ func Rodin3D_Gen2(gr *Graph, images IMAGE, tapose bool, seed int, material_type,
 			"TAPose": Bool(tapose),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // Rodin3D_Regular - Rodin 3D Generate - Regular Generate
-func Rodin3D_Regular(gr *Graph, images IMAGE, seed int, material_type, polygon_count string) (_ *Node, _3d_model_path STRING) {
+func Rodin3D_Regular(gr *Graph, images IMAGE, seed int, material_type, polygon_count string, opts ...NodeOption) (_ *Node, _3d_model_path STRING) {
 	nd := &Node{
 		Class: "Rodin3D_Regular",
 		Inputs: map[string]Value{
 			"Images": Link(images),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // Rodin3D_Sketch - Rodin 3D Generate - Sketch Generate
-func Rodin3D_Sketch(gr *Graph, images IMAGE, seed int) (_ *Node, _3d_model_path STRING) {
+func Rodin3D_Sketch(gr *Graph, images IMAGE, seed int, opts ...NodeOption) (_ *Node, _3d_model_path STRING) {
 	nd := &Node{
 		Class: "Rodin3D_Sketch",
 		Inputs: map[string]Value{
 			"Images": Link(images),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // Rodin3D_Smooth - Rodin 3D Generate - Smooth Generate
-func Rodin3D_Smooth(gr *Graph, images IMAGE, seed int, material_type, polygon_count string) (_ *Node, _3d_model_path STRING) {
+func Rodin3D_Smooth(gr *Graph, images IMAGE, seed int, material_type, polygon_count string, opts ...NodeOption) (_ *Node, _3d_model_path STRING) {
 	nd := &Node{
 		Class: "Rodin3D_Smooth",
 		Inputs: map[string]Value{
 			"Images": Link(images),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // RunwayFirstLastFrameNode - Runway First-Last-Frame to Video
-func RunwayFirstLastFrameNode(gr *Graph, start_frame IMAGE, end_frame IMAGE, prompt string, duration, ratio string, seed int) (_ *Node, video VIDEO) {
+func RunwayFirstLastFrameNode(gr *Graph, start_frame IMAGE, end_frame IMAGE, prompt string, duration, ratio string, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "RunwayFirstLastFrameNode",
 		Inputs: map[string]Value{
@@ -15745,12 +16200,13 @@ func RunwayFirstLastFrameNode(gr *Graph, start_frame IMAGE, end_frame IMAGE, pro
 			"seed":        Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // RunwayImageToVideoNodeGen3a - Runway Image to Video (Gen3a Turbo)
-func RunwayImageToVideoNodeGen3a(gr *Graph, start_frame IMAGE, prompt string, duration, ratio string, seed int) (_ *Node, video VIDEO) {
+func RunwayImageToVideoNodeGen3a(gr *Graph, start_frame IMAGE, prompt string, duration, ratio string, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "RunwayImageToVideoNodeGen3a",
 		Inputs: map[string]Value{
@@ -15761,12 +16217,13 @@ func RunwayImageToVideoNodeGen3a(gr *Graph, start_frame IMAGE, prompt string, du
 			"seed":        Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // RunwayImageToVideoNodeGen4 - Runway Image to Video (Gen4 Turbo)
-func RunwayImageToVideoNodeGen4(gr *Graph, start_frame IMAGE, prompt string, duration, ratio string, seed int) (_ *Node, video VIDEO) {
+func RunwayImageToVideoNodeGen4(gr *Graph, start_frame IMAGE, prompt string, duration, ratio string, seed int, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "RunwayImageToVideoNodeGen4",
 		Inputs: map[string]Value{
@@ -15777,12 +16234,13 @@ func RunwayImageToVideoNodeGen4(gr *Graph, start_frame IMAGE, prompt string, dur
 			"seed":        Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // RunwayTextToImageNode - Runway Text to Image
-func RunwayTextToImageNode(gr *Graph, reference_image IMAGE, prompt string, ratio string) (_ *Node, image IMAGE) {
+func RunwayTextToImageNode(gr *Graph, reference_image IMAGE, prompt string, ratio string, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "RunwayTextToImageNode",
 		Inputs: map[string]Value{
@@ -15790,11 +16248,12 @@ func RunwayTextToImageNode(gr *Graph, reference_image IMAGE, prompt string, rati
 			"ratio":  String(ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func SDTurboScheduler(gr *Graph, model MODEL, steps int, denoise float64) (_ *Node, sigmas SIGMAS) {
+func SDTurboScheduler(gr *Graph, model MODEL, steps int, denoise float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "SDTurboScheduler",
 		Inputs: map[string]Value{
@@ -15803,11 +16262,12 @@ func SDTurboScheduler(gr *Graph, model MODEL, steps int, denoise float64) (_ *No
 			"denoise": Float(denoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
-func SD_4XUpscale_Conditioning(gr *Graph, images IMAGE, positive CONDITIONING, negative CONDITIONING, scale_ratio, noise_augmentation float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func SD_4XUpscale_Conditioning(gr *Graph, images IMAGE, positive CONDITIONING, negative CONDITIONING, scale_ratio, noise_augmentation float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "SD_4XUpscale_Conditioning",
 		Inputs: map[string]Value{
@@ -15818,11 +16278,12 @@ func SD_4XUpscale_Conditioning(gr *Graph, images IMAGE, positive CONDITIONING, n
 			"noise_augmentation": Float(noise_augmentation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func SV3D_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, video_frames int, elevation float64) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
+func SV3D_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, video_frames int, elevation float64, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "SV3D_Conditioning",
 		Inputs: map[string]Value{
@@ -15835,11 +16296,12 @@ func SV3D_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae
 			"elevation":    Float(elevation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func SVD_img2vid_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, video_frames, motion_bucket_id, fps int, augmentation_level float64) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
+func SVD_img2vid_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, video_frames, motion_bucket_id, fps int, augmentation_level float64, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "SVD_img2vid_Conditioning",
 		Inputs: map[string]Value{
@@ -15854,11 +16316,12 @@ func SVD_img2vid_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMA
 			"augmentation_level": Float(augmentation_level),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func SamplerCustom(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, sampler SAMPLER, sigmas SIGMAS, latent_image LATENT, add_noise bool, noise_seed int, cfg float64) (_ *Node, output LATENT, denoised_output LATENT) {
+func SamplerCustom(gr *Graph, model MODEL, positive CONDITIONING, negative CONDITIONING, sampler SAMPLER, sigmas SIGMAS, latent_image LATENT, add_noise bool, noise_seed int, cfg float64, opts ...NodeOption) (_ *Node, output LATENT, denoised_output LATENT) {
 	nd := &Node{
 		Class: "SamplerCustom",
 		Inputs: map[string]Value{
@@ -15873,11 +16336,12 @@ func SamplerCustom(gr *Graph, model MODEL, positive CONDITIONING, negative CONDI
 			"latent_image": Link(latent_image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, LATENT{NodeID: id, OutPort: 1}
 }
 
-func SamplerCustomAdvanced(gr *Graph, noise NOISE, guider GUIDER, sampler SAMPLER, sigmas SIGMAS, latent_image LATENT) (_ *Node, output LATENT, denoised_output LATENT) {
+func SamplerCustomAdvanced(gr *Graph, noise NOISE, guider GUIDER, sampler SAMPLER, sigmas SIGMAS, latent_image LATENT, opts ...NodeOption) (_ *Node, output LATENT, denoised_output LATENT) {
 	nd := &Node{
 		Class: "SamplerCustomAdvanced",
 		Inputs: map[string]Value{
@@ -15888,11 +16352,12 @@ func SamplerCustomAdvanced(gr *Graph, noise NOISE, guider GUIDER, sampler SAMPLE
 			"latent_image": Link(latent_image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, LATENT{NodeID: id, OutPort: 1}
 }
 
-func SamplerDPMAdaptative(gr *Graph, order int, rtol, atol, h_init, pcoeff, icoeff, dcoeff, accept_safety, eta, s_noise float64) (_ *Node, sampler SAMPLER) {
+func SamplerDPMAdaptative(gr *Graph, order int, rtol, atol, h_init, pcoeff, icoeff, dcoeff, accept_safety, eta, s_noise float64, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerDPMAdaptative",
 		Inputs: map[string]Value{
@@ -15908,11 +16373,12 @@ func SamplerDPMAdaptative(gr *Graph, order int, rtol, atol, h_init, pcoeff, icoe
 			"s_noise":       Float(s_noise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerDPMPP_2M_SDE(gr *Graph, solver_type string, eta, s_noise float64, noise_device string) (_ *Node, sampler SAMPLER) {
+func SamplerDPMPP_2M_SDE(gr *Graph, solver_type string, eta, s_noise float64, noise_device string, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerDPMPP_2M_SDE",
 		Inputs: map[string]Value{
@@ -15922,11 +16388,12 @@ func SamplerDPMPP_2M_SDE(gr *Graph, solver_type string, eta, s_noise float64, no
 			"noise_device": String(noise_device),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerDPMPP_2S_Ancestral(gr *Graph, eta, s_noise float64) (_ *Node, sampler SAMPLER) {
+func SamplerDPMPP_2S_Ancestral(gr *Graph, eta, s_noise float64, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerDPMPP_2S_Ancestral",
 		Inputs: map[string]Value{
@@ -15934,11 +16401,12 @@ func SamplerDPMPP_2S_Ancestral(gr *Graph, eta, s_noise float64) (_ *Node, sample
 			"s_noise": Float(s_noise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerDPMPP_3M_SDE(gr *Graph, eta, s_noise float64, noise_device string) (_ *Node, sampler SAMPLER) {
+func SamplerDPMPP_3M_SDE(gr *Graph, eta, s_noise float64, noise_device string, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerDPMPP_3M_SDE",
 		Inputs: map[string]Value{
@@ -15947,11 +16415,12 @@ func SamplerDPMPP_3M_SDE(gr *Graph, eta, s_noise float64, noise_device string) (
 			"noise_device": String(noise_device),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerDPMPP_SDE(gr *Graph, eta, s_noise, r float64, noise_device string) (_ *Node, sampler SAMPLER) {
+func SamplerDPMPP_SDE(gr *Graph, eta, s_noise, r float64, noise_device string, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerDPMPP_SDE",
 		Inputs: map[string]Value{
@@ -15961,11 +16430,12 @@ func SamplerDPMPP_SDE(gr *Graph, eta, s_noise, r float64, noise_device string) (
 			"noise_device": String(noise_device),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerER_SDE(gr *Graph, solver_type string, max_stage int, eta, s_noise float64) (_ *Node, sampler SAMPLER) {
+func SamplerER_SDE(gr *Graph, solver_type string, max_stage int, eta, s_noise float64, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerER_SDE",
 		Inputs: map[string]Value{
@@ -15975,11 +16445,12 @@ func SamplerER_SDE(gr *Graph, solver_type string, max_stage int, eta, s_noise fl
 			"s_noise":     Float(s_noise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerEulerAncestral(gr *Graph, eta, s_noise float64) (_ *Node, sampler SAMPLER) {
+func SamplerEulerAncestral(gr *Graph, eta, s_noise float64, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerEulerAncestral",
 		Inputs: map[string]Value{
@@ -15987,12 +16458,13 @@ func SamplerEulerAncestral(gr *Graph, eta, s_noise float64) (_ *Node, sampler SA
 			"s_noise": Float(s_noise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
 // SamplerEulerAncestralCFGPP - SamplerEulerAncestralCFG++
-func SamplerEulerAncestralCFGPP(gr *Graph, eta, s_noise float64) (_ *Node, sampler SAMPLER) {
+func SamplerEulerAncestralCFGPP(gr *Graph, eta, s_noise float64, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerEulerAncestralCFGPP",
 		Inputs: map[string]Value{
@@ -16000,23 +16472,25 @@ func SamplerEulerAncestralCFGPP(gr *Graph, eta, s_noise float64) (_ *Node, sampl
 			"s_noise": Float(s_noise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
 // SamplerEulerCFGpp - SamplerEulerCFG++
-func SamplerEulerCFGpp(gr *Graph, version string) (_ *Node, sampler SAMPLER) {
+func SamplerEulerCFGpp(gr *Graph, version string, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerEulerCFGpp",
 		Inputs: map[string]Value{
 			"version": String(version),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerLCMUpscale(gr *Graph, scale_ratio float64, scale_steps int, upscale_method string) (_ *Node, sampler SAMPLER) {
+func SamplerLCMUpscale(gr *Graph, scale_ratio float64, scale_steps int, upscale_method string, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerLCMUpscale",
 		Inputs: map[string]Value{
@@ -16025,22 +16499,24 @@ func SamplerLCMUpscale(gr *Graph, scale_ratio float64, scale_steps int, upscale_
 			"upscale_method": String(upscale_method),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerLMS(gr *Graph, order int) (_ *Node, sampler SAMPLER) {
+func SamplerLMS(gr *Graph, order int, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerLMS",
 		Inputs: map[string]Value{
 			"order": Int(order),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerSASolver(gr *Graph, model MODEL, eta, sde_start_percent, sde_end_percent, s_noise float64, predictor_order, corrector_order int, use_pece, simple_order_2 bool) (_ *Node, sampler SAMPLER) {
+func SamplerSASolver(gr *Graph, model MODEL, eta, sde_start_percent, sde_end_percent, s_noise float64, predictor_order, corrector_order int, use_pece, simple_order_2 bool, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerSASolver",
 		Inputs: map[string]Value{
@@ -16055,11 +16531,12 @@ func SamplerSASolver(gr *Graph, model MODEL, eta, sde_start_percent, sde_end_per
 			"simple_order_2":    Bool(simple_order_2),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplerSEEDS2(gr *Graph, solver_type string, eta, s_noise, r float64) (_ *Node, sampler SAMPLER) {
+func SamplerSEEDS2(gr *Graph, solver_type string, eta, s_noise, r float64, opts ...NodeOption) (_ *Node, sampler SAMPLER) {
 	nd := &Node{
 		Class: "SamplerSEEDS2",
 		Inputs: map[string]Value{
@@ -16069,11 +16546,12 @@ func SamplerSEEDS2(gr *Graph, solver_type string, eta, s_noise, r float64) (_ *N
 			"r":           Float(r),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SAMPLER{NodeID: id, OutPort: 0}
 }
 
-func SamplingPercentToSigma(gr *Graph, model MODEL, sampling_percent float64, return_actual_sigma bool) (_ *Node, sigma_value FLOAT) {
+func SamplingPercentToSigma(gr *Graph, model MODEL, sampling_percent float64, return_actual_sigma bool, opts ...NodeOption) (_ *Node, sigma_value FLOAT) {
 	nd := &Node{
 		Class: "SamplingPercentToSigma",
 		Inputs: map[string]Value{
@@ -16082,11 +16560,12 @@ func SamplingPercentToSigma(gr *Graph, model MODEL, sampling_percent float64, re
 			"return_actual_sigma": Bool(return_actual_sigma),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, FLOAT{NodeID: id, OutPort: 0}
 }
 
-func SaveAnimatedPNG(gr *Graph, images IMAGE, filename_prefix string, fps float64, compress_level int) (_ *Node) {
+func SaveAnimatedPNG(gr *Graph, images IMAGE, filename_prefix string, fps float64, compress_level int, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "SaveAnimatedPNG",
 		Inputs: map[string]Value{
@@ -16096,11 +16575,12 @@ func SaveAnimatedPNG(gr *Graph, images IMAGE, filename_prefix string, fps float6
 			"compress_level":  Int(compress_level),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
-func SaveAnimatedWEBP(gr *Graph, images IMAGE, filename_prefix string, fps float64, lossless bool, quality int, method string) (_ *Node) {
+func SaveAnimatedWEBP(gr *Graph, images IMAGE, filename_prefix string, fps float64, lossless bool, quality int, method string, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "SaveAnimatedWEBP",
 		Inputs: map[string]Value{
@@ -16112,12 +16592,13 @@ func SaveAnimatedWEBP(gr *Graph, images IMAGE, filename_prefix string, fps float
 			"method":          String(method),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
 // SaveAudio - Save Audio (FLAC)
-func SaveAudio(gr *Graph, audio AUDIO, filename_prefix string) (_ *Node) {
+func SaveAudio(gr *Graph, audio AUDIO, filename_prefix string, opts ...NodeOption) (_ *Node, _ AudioOutput) {
 	nd := &Node{
 		Class: "SaveAudio",
 		Inputs: map[string]Value{
@@ -16125,12 +16606,13 @@ func SaveAudio(gr *Graph, audio AUDIO, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, AudioOutput{Output{NodeID: id}}
 }
 
 // SaveAudioMP3 - Save Audio (MP3)
-func SaveAudioMP3(gr *Graph, audio AUDIO, filename_prefix string, quality string) (_ *Node) {
+func SaveAudioMP3(gr *Graph, audio AUDIO, filename_prefix string, quality string, opts ...NodeOption) (_ *Node, _ AudioOutput) {
 	nd := &Node{
 		Class: "SaveAudioMP3",
 		Inputs: map[string]Value{
@@ -16139,12 +16621,13 @@ func SaveAudioMP3(gr *Graph, audio AUDIO, filename_prefix string, quality string
 			"quality":         String(quality),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, AudioOutput{Output{NodeID: id}}
 }
 
 // SaveAudioOpus - Save Audio (Opus)
-func SaveAudioOpus(gr *Graph, audio AUDIO, filename_prefix string, quality string) (_ *Node) {
+func SaveAudioOpus(gr *Graph, audio AUDIO, filename_prefix string, quality string, opts ...NodeOption) (_ *Node, _ AudioOutput) {
 	nd := &Node{
 		Class: "SaveAudioOpus",
 		Inputs: map[string]Value{
@@ -16153,11 +16636,12 @@ func SaveAudioOpus(gr *Graph, audio AUDIO, filename_prefix string, quality strin
 			"quality":         String(quality),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, AudioOutput{Output{NodeID: id}}
 }
 
-func SaveGLB(gr *Graph, mesh MESH, filename_prefix string) (_ *Node) {
+func SaveGLB(gr *Graph, mesh MESH, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "SaveGLB",
 		Inputs: map[string]Value{
@@ -16165,12 +16649,13 @@ func SaveGLB(gr *Graph, mesh MESH, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // SaveImage - Save Image
-func SaveImage(gr *Graph, images IMAGE, filename_prefix string) (_ *Node) {
+func SaveImage(gr *Graph, images IMAGE, filename_prefix string, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "SaveImage",
 		Inputs: map[string]Value{
@@ -16178,12 +16663,13 @@ func SaveImage(gr *Graph, images IMAGE, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
 // SaveImageDataSetToFolder - Save Image Dataset to Folder
-func SaveImageDataSetToFolder(gr *Graph, images IMAGE, folder_name, filename_prefix string) (_ *Node) {
+func SaveImageDataSetToFolder(gr *Graph, images IMAGE, folder_name, filename_prefix string, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "SaveImageDataSetToFolder",
 		Inputs: map[string]Value{
@@ -16192,12 +16678,13 @@ func SaveImageDataSetToFolder(gr *Graph, images IMAGE, folder_name, filename_pre
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
 // SaveImageTextDataSetToFolder - Save Image and Text Dataset to Folder
-func SaveImageTextDataSetToFolder(gr *Graph, images IMAGE, texts, folder_name, filename_prefix string) (_ *Node) {
+func SaveImageTextDataSetToFolder(gr *Graph, images IMAGE, texts, folder_name, filename_prefix string, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "SaveImageTextDataSetToFolder",
 		Inputs: map[string]Value{
@@ -16207,22 +16694,24 @@ func SaveImageTextDataSetToFolder(gr *Graph, images IMAGE, texts, folder_name, f
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
-func SaveImageWebsocket(gr *Graph, images IMAGE) (_ *Node) {
+func SaveImageWebsocket(gr *Graph, images IMAGE, opts ...NodeOption) (_ *Node, _ ImageOutput) {
 	nd := &Node{
 		Class: "SaveImageWebsocket",
 		Inputs: map[string]Value{
 			"images": Link(images),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, ImageOutput{Output{NodeID: id}}
 }
 
-func SaveLatent(gr *Graph, samples LATENT, filename_prefix string) (_ *Node) {
+func SaveLatent(gr *Graph, samples LATENT, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "SaveLatent",
 		Inputs: map[string]Value{
@@ -16230,12 +16719,13 @@ func SaveLatent(gr *Graph, samples LATENT, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // SaveLoRA - Save LoRA Weights
-func SaveLoRA(gr *Graph, lora LORA_MODEL, prefix string, steps int) (_ *Node) {
+func SaveLoRA(gr *Graph, lora LORA_MODEL, prefix string, steps int, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "SaveLoRA",
 		Inputs: map[string]Value{
@@ -16243,11 +16733,12 @@ func SaveLoRA(gr *Graph, lora LORA_MODEL, prefix string, steps int) (_ *Node) {
 			"prefix": String(prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
-func SaveSVGNode(gr *Graph, svg SVG, filename_prefix string) (_ *Node) {
+func SaveSVGNode(gr *Graph, svg SVG, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "SaveSVGNode",
 		Inputs: map[string]Value{
@@ -16255,12 +16746,13 @@ func SaveSVGNode(gr *Graph, svg SVG, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // SaveTrainingDataset - Save Training Dataset
-func SaveTrainingDataset(gr *Graph, latents LATENT, conditioning CONDITIONING, folder_name string, shard_size int) (_ *Node) {
+func SaveTrainingDataset(gr *Graph, latents LATENT, conditioning CONDITIONING, folder_name string, shard_size int, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "SaveTrainingDataset",
 		Inputs: map[string]Value{
@@ -16270,12 +16762,13 @@ func SaveTrainingDataset(gr *Graph, latents LATENT, conditioning CONDITIONING, f
 			"shard_size":   Int(shard_size),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // SaveVideo - Save Video
-func SaveVideo(gr *Graph, video VIDEO, filename_prefix string, format, codec string) (_ *Node) {
+func SaveVideo(gr *Graph, video VIDEO, filename_prefix string, format, codec string, opts ...NodeOption) (_ *Node, _ VideoOutput) {
 	nd := &Node{
 		Class: "SaveVideo",
 		Inputs: map[string]Value{
@@ -16285,11 +16778,12 @@ func SaveVideo(gr *Graph, video VIDEO, filename_prefix string, format, codec str
 			"codec":           String(codec),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, VideoOutput{Output{NodeID: id}}
 }
 
-func SaveWEBM(gr *Graph, images IMAGE, filename_prefix string, codec string, fps, crf float64) (_ *Node) {
+func SaveWEBM(gr *Graph, images IMAGE, filename_prefix string, codec string, fps, crf float64, opts ...NodeOption) (_ *Node, _ VideoOutput) {
 	nd := &Node{
 		Class: "SaveWEBM",
 		Inputs: map[string]Value{
@@ -16300,11 +16794,12 @@ func SaveWEBM(gr *Graph, images IMAGE, filename_prefix string, codec string, fps
 			"crf":             Float(crf),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, VideoOutput{Output{NodeID: id}}
 }
 
-func ScaleROPE(gr *Graph, model MODEL, scale_x, shift_x, scale_y, shift_y, scale_t, shift_t float64) (_ *Node, out_model MODEL) {
+func ScaleROPE(gr *Graph, model MODEL, scale_x, shift_x, scale_y, shift_y, scale_t, shift_t float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ScaleROPE",
 		Inputs: map[string]Value{
@@ -16317,12 +16812,13 @@ func ScaleROPE(gr *Graph, model MODEL, scale_x, shift_x, scale_y, shift_y, scale
 			"shift_t": Float(shift_t),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // SelfAttentionGuidance - Self-Attention Guidance
-func SelfAttentionGuidance(gr *Graph, model MODEL, scale, blur_sigma float64) (_ *Node, out_model MODEL) {
+func SelfAttentionGuidance(gr *Graph, model MODEL, scale, blur_sigma float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "SelfAttentionGuidance",
 		Inputs: map[string]Value{
@@ -16331,12 +16827,13 @@ func SelfAttentionGuidance(gr *Graph, model MODEL, scale, blur_sigma float64) (_
 			"blur_sigma": Float(blur_sigma),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // SetClipHooks - Set CLIP Hooks
-func SetClipHooks(gr *Graph, clip CLIP, hooks HOOKS, apply_to_conds, schedule_clip bool) (_ *Node, out_clip CLIP) {
+func SetClipHooks(gr *Graph, clip CLIP, hooks HOOKS, apply_to_conds, schedule_clip bool, opts ...NodeOption) (_ *Node, out_clip CLIP) {
 	nd := &Node{
 		Class: "SetClipHooks",
 		Inputs: map[string]Value{
@@ -16345,11 +16842,12 @@ func SetClipHooks(gr *Graph, clip CLIP, hooks HOOKS, apply_to_conds, schedule_cl
 			"schedule_clip":  Bool(schedule_clip),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
-func SetFirstSigma(gr *Graph, sigmas SIGMAS, sigma float64) (_ *Node, out_sigmas SIGMAS) {
+func SetFirstSigma(gr *Graph, sigmas SIGMAS, sigma float64, opts ...NodeOption) (_ *Node, out_sigmas SIGMAS) {
 	nd := &Node{
 		Class: "SetFirstSigma",
 		Inputs: map[string]Value{
@@ -16357,24 +16855,26 @@ func SetFirstSigma(gr *Graph, sigmas SIGMAS, sigma float64) (_ *Node, out_sigmas
 			"sigma":  Float(sigma),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // SetHookKeyframes - Set Hook Keyframes
-func SetHookKeyframes(gr *Graph, hooks HOOKS, hook_kf HOOK_KEYFRAMES) (_ *Node, out_hooks HOOKS) {
+func SetHookKeyframes(gr *Graph, hooks HOOKS, hook_kf HOOK_KEYFRAMES, opts ...NodeOption) (_ *Node, out_hooks HOOKS) {
 	nd := &Node{
 		Class: "SetHookKeyframes",
 		Inputs: map[string]Value{
 			"hooks": Link(hooks),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, HOOKS{NodeID: id, OutPort: 0}
 }
 
 // SetLatentNoiseMask - Set Latent Noise Mask
-func SetLatentNoiseMask(gr *Graph, samples LATENT, mask MASK) (_ *Node, latent LATENT) {
+func SetLatentNoiseMask(gr *Graph, samples LATENT, mask MASK, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "SetLatentNoiseMask",
 		Inputs: map[string]Value{
@@ -16382,11 +16882,12 @@ func SetLatentNoiseMask(gr *Graph, samples LATENT, mask MASK) (_ *Node, latent L
 			"mask":    Link(mask),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func SetUnionControlNetType(gr *Graph, control_net CONTROL_NET, typ string) (_ *Node, out_control_net CONTROL_NET) {
+func SetUnionControlNetType(gr *Graph, control_net CONTROL_NET, typ string, opts ...NodeOption) (_ *Node, out_control_net CONTROL_NET) {
 	nd := &Node{
 		Class: "SetUnionControlNetType",
 		Inputs: map[string]Value{
@@ -16394,12 +16895,13 @@ func SetUnionControlNetType(gr *Graph, control_net CONTROL_NET, typ string) (_ *
 			"type":        String(typ),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONTROL_NET{NodeID: id, OutPort: 0}
 }
 
 // ShuffleDataset - Shuffle Image Dataset
-func ShuffleDataset(gr *Graph, images IMAGE, seed int) (_ *Node, out_images IMAGE) {
+func ShuffleDataset(gr *Graph, images IMAGE, seed int, opts ...NodeOption) (_ *Node, out_images IMAGE) {
 	nd := &Node{
 		Class: "ShuffleDataset",
 		Inputs: map[string]Value{
@@ -16407,12 +16909,13 @@ func ShuffleDataset(gr *Graph, images IMAGE, seed int) (_ *Node, out_images IMAG
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // ShuffleImageTextDataset - Shuffle Image-Text Dataset
-func ShuffleImageTextDataset(gr *Graph, images IMAGE, texts string, seed int) (_ *Node, out_images IMAGE, out_texts STRING) {
+func ShuffleImageTextDataset(gr *Graph, images IMAGE, texts string, seed int, opts ...NodeOption) (_ *Node, out_images IMAGE, out_texts STRING) {
 	nd := &Node{
 		Class: "ShuffleImageTextDataset",
 		Inputs: map[string]Value{
@@ -16421,11 +16924,12 @@ func ShuffleImageTextDataset(gr *Graph, images IMAGE, texts string, seed int) (_
 			"seed":   Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, STRING{NodeID: id, OutPort: 1}
 }
 
-func SkipLayerGuidanceDiT(gr *Graph, model MODEL, double_layers, single_layers string, scale, start_percent, end_percent, rescaling_scale float64) (_ *Node, out_model MODEL) {
+func SkipLayerGuidanceDiT(gr *Graph, model MODEL, double_layers, single_layers string, scale, start_percent, end_percent, rescaling_scale float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "SkipLayerGuidanceDiT",
 		Inputs: map[string]Value{
@@ -16438,11 +16942,12 @@ func SkipLayerGuidanceDiT(gr *Graph, model MODEL, double_layers, single_layers s
 			"rescaling_scale": Float(rescaling_scale),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func SkipLayerGuidanceDiTSimple(gr *Graph, model MODEL, double_layers, single_layers string, start_percent, end_percent float64) (_ *Node, out_model MODEL) {
+func SkipLayerGuidanceDiTSimple(gr *Graph, model MODEL, double_layers, single_layers string, start_percent, end_percent float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "SkipLayerGuidanceDiTSimple",
 		Inputs: map[string]Value{
@@ -16453,11 +16958,12 @@ func SkipLayerGuidanceDiTSimple(gr *Graph, model MODEL, double_layers, single_la
 			"end_percent":   Float(end_percent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func SkipLayerGuidanceSD3(gr *Graph, model MODEL, layers string, scale, start_percent, end_percent float64) (_ *Node, out_model MODEL) {
+func SkipLayerGuidanceSD3(gr *Graph, model MODEL, layers string, scale, start_percent, end_percent float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "SkipLayerGuidanceSD3",
 		Inputs: map[string]Value{
@@ -16468,11 +16974,12 @@ func SkipLayerGuidanceSD3(gr *Graph, model MODEL, layers string, scale, start_pe
 			"end_percent":   Float(end_percent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func SolidMask(gr *Graph, value float64, width, height int) (_ *Node, mask MASK) {
+func SolidMask(gr *Graph, value float64, width, height int, opts ...NodeOption) (_ *Node, mask MASK) {
 	nd := &Node{
 		Class: "SolidMask",
 		Inputs: map[string]Value{
@@ -16481,35 +16988,38 @@ func SolidMask(gr *Graph, value float64, width, height int) (_ *Node, mask MASK)
 			"height": Int(height),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
 // SplitAudioChannels - Split Audio Channels
-func SplitAudioChannels(gr *Graph, audio AUDIO) (_ *Node, left AUDIO, right AUDIO) {
+func SplitAudioChannels(gr *Graph, audio AUDIO, opts ...NodeOption) (_ *Node, left AUDIO, right AUDIO) {
 	nd := &Node{
 		Class: "SplitAudioChannels",
 		Inputs: map[string]Value{
 			"audio": Link(audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}, AUDIO{NodeID: id, OutPort: 1}
 }
 
 // SplitImageWithAlpha - Split Image with Alpha
-func SplitImageWithAlpha(gr *Graph, image IMAGE) (_ *Node, out_image IMAGE, mask MASK) {
+func SplitImageWithAlpha(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE, mask MASK) {
 	nd := &Node{
 		Class: "SplitImageWithAlpha",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, MASK{NodeID: id, OutPort: 1}
 }
 
-func SplitSigmas(gr *Graph, sigmas SIGMAS, step int) (_ *Node, high_sigmas SIGMAS, low_sigmas SIGMAS) {
+func SplitSigmas(gr *Graph, sigmas SIGMAS, step int, opts ...NodeOption) (_ *Node, high_sigmas SIGMAS, low_sigmas SIGMAS) {
 	nd := &Node{
 		Class: "SplitSigmas",
 		Inputs: map[string]Value{
@@ -16517,11 +17027,12 @@ func SplitSigmas(gr *Graph, sigmas SIGMAS, step int) (_ *Node, high_sigmas SIGMA
 			"step":   Int(step),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}, SIGMAS{NodeID: id, OutPort: 1}
 }
 
-func SplitSigmasDenoise(gr *Graph, sigmas SIGMAS, denoise float64) (_ *Node, high_sigmas SIGMAS, low_sigmas SIGMAS) {
+func SplitSigmasDenoise(gr *Graph, sigmas SIGMAS, denoise float64, opts ...NodeOption) (_ *Node, high_sigmas SIGMAS, low_sigmas SIGMAS) {
 	nd := &Node{
 		Class: "SplitSigmasDenoise",
 		Inputs: map[string]Value{
@@ -16529,12 +17040,13 @@ func SplitSigmasDenoise(gr *Graph, sigmas SIGMAS, denoise float64) (_ *Node, hig
 			"denoise": Float(denoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}, SIGMAS{NodeID: id, OutPort: 1}
 }
 
 // StabilityAudioInpaint - Stability AI Audio Inpaint
-func StabilityAudioInpaint(gr *Graph, audio AUDIO, model string, prompt string, duration, seed, steps, mask_start, mask_end int) (_ *Node, out_audio AUDIO) {
+func StabilityAudioInpaint(gr *Graph, audio AUDIO, model string, prompt string, duration, seed, steps, mask_start, mask_end int, opts ...NodeOption) (_ *Node, out_audio AUDIO) {
 	nd := &Node{
 		Class: "StabilityAudioInpaint",
 		Inputs: map[string]Value{
@@ -16543,12 +17055,13 @@ func StabilityAudioInpaint(gr *Graph, audio AUDIO, model string, prompt string,
 			"audio":  Link(audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // StabilityAudioToAudio - Stability AI Audio To Audio
-func StabilityAudioToAudio(gr *Graph, audio AUDIO, model string, prompt string, duration, seed, steps int, strength float64) (_ *Node, out_audio AUDIO) {
+func StabilityAudioToAudio(gr *Graph, audio AUDIO, model string, prompt string, duration, seed, steps int, strength float64, opts ...NodeOption) (_ *Node, out_audio AUDIO) {
 	nd := &Node{
 		Class: "StabilityAudioToAudio",
 		Inputs: map[string]Value{
@@ -16557,12 +17070,13 @@ func StabilityAudioToAudio(gr *Graph, audio AUDIO, model string, prompt string,
 			"audio":  Link(audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // StabilityStableImageSD_3_5Node - Stability AI Stable Diffusion 3.5 Image
-func StabilityStableImageSD_3_5Node(gr *Graph, image IMAGE, prompt string, model, aspect_ratio, style_preset string, cfg_scale float64, seed int, negative_prompt string, image_denoise float64) (_ *Node, out_image IMAGE) {
+func StabilityStableImageSD_3_5Node(gr *Graph, image IMAGE, prompt string, model, aspect_ratio, style_preset string, cfg_scale float64, seed int, negative_prompt string, image_denoise float64, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "StabilityStableImageSD_3_5Node",
 		Inputs: map[string]Value{
@@ -16574,12 +17088,13 @@ func StabilityStableImageSD_3_5Node(gr *Graph, image IMAGE, prompt string, model
 			"seed":         Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // StabilityStableImageUltraNode - Stability AI Stable Image Ultra
-func StabilityStableImageUltraNode(gr *Graph, image IMAGE, prompt string, aspect_ratio, style_preset string, seed int, negative_prompt string, image_denoise float64) (_ *Node, out_image IMAGE) {
+func StabilityStableImageUltraNode(gr *Graph, image IMAGE, prompt string, aspect_ratio, style_preset string, seed int, negative_prompt string, image_denoise float64, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "StabilityStableImageUltraNode",
 		Inputs: map[string]Value{
@@ -16589,12 +17104,13 @@ func StabilityStableImageUltraNode(gr *Graph, image IMAGE, prompt string, aspect
 			"seed":         Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // StabilityTextToAudio - Stability AI Text To Audio
-func StabilityTextToAudio(gr *Graph, model string, prompt string, duration, seed, steps int) (_ *Node, audio AUDIO) {
+func StabilityTextToAudio(gr *Graph, model string, prompt string, duration, seed, steps int, opts ...NodeOption) (_ *Node, audio AUDIO) {
 	nd := &Node{
 		Class: "StabilityTextToAudio",
 		Inputs: map[string]Value{
@@ -16602,12 +17118,13 @@ func StabilityTextToAudio(gr *Graph, model string, prompt string, duration, seed
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
 // StabilityUpscaleConservativeNode - Stability AI Upscale Conservative
-func StabilityUpscaleConservativeNode(gr *Graph, image IMAGE, prompt string, creativity float64, seed int, negative_prompt string) (_ *Node, out_image IMAGE) {
+func StabilityUpscaleConservativeNode(gr *Graph, image IMAGE, prompt string, creativity float64, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "StabilityUpscaleConservativeNode",
 		Inputs: map[string]Value{
@@ -16617,12 +17134,13 @@ func StabilityUpscaleConservativeNode(gr *Graph, image IMAGE, prompt string, cre
 			"seed":       Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // StabilityUpscaleCreativeNode - Stability AI Upscale Creative
-func StabilityUpscaleCreativeNode(gr *Graph, image IMAGE, prompt string, creativity float64, style_preset string, seed int, negative_prompt string) (_ *Node, out_image IMAGE) {
+func StabilityUpscaleCreativeNode(gr *Graph, image IMAGE, prompt string, creativity float64, style_preset string, seed int, negative_prompt string, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "StabilityUpscaleCreativeNode",
 		Inputs: map[string]Value{
@@ -16633,23 +17151,25 @@ func StabilityUpscaleCreativeNode(gr *Graph, image IMAGE, prompt string, creativ
 			"seed":         Int(seed),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // StabilityUpscaleFastNode - Stability AI Upscale Fast
-func StabilityUpscaleFastNode(gr *Graph, image IMAGE) (_ *Node, out_image IMAGE) {
+func StabilityUpscaleFastNode(gr *Graph, image IMAGE, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "StabilityUpscaleFastNode",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func StableCascade_EmptyLatentImage(gr *Graph, width, height, compression, batch_size int) (_ *Node, stage_c LATENT, stage_b LATENT) {
+func StableCascade_EmptyLatentImage(gr *Graph, width, height, compression, batch_size int, opts ...NodeOption) (_ *Node, stage_c LATENT, stage_b LATENT) {
 	nd := &Node{
 		Class: "StableCascade_EmptyLatentImage",
 		Inputs: map[string]Value{
@@ -16659,11 +17179,12 @@ func StableCascade_EmptyLatentImage(gr *Graph, width, height, compression, batch
 			"batch_size":  Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, LATENT{NodeID: id, OutPort: 1}
 }
 
-func StableCascade_StageB_Conditioning(gr *Graph, conditioning CONDITIONING, stage_c LATENT) (_ *Node, out_conditioning CONDITIONING) {
+func StableCascade_StageB_Conditioning(gr *Graph, conditioning CONDITIONING, stage_c LATENT, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "StableCascade_StageB_Conditioning",
 		Inputs: map[string]Value{
@@ -16671,11 +17192,12 @@ func StableCascade_StageB_Conditioning(gr *Graph, conditioning CONDITIONING, sta
 			"stage_c":      Link(stage_c),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func StableCascade_StageC_VAEEncode(gr *Graph, image IMAGE, vae VAE, compression int) (_ *Node, stage_c LATENT, stage_b LATENT) {
+func StableCascade_StageC_VAEEncode(gr *Graph, image IMAGE, vae VAE, compression int, opts ...NodeOption) (_ *Node, stage_c LATENT, stage_b LATENT) {
 	nd := &Node{
 		Class: "StableCascade_StageC_VAEEncode",
 		Inputs: map[string]Value{
@@ -16684,11 +17206,12 @@ func StableCascade_StageC_VAEEncode(gr *Graph, image IMAGE, vae VAE, compression
 			"compression": Int(compression),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}, LATENT{NodeID: id, OutPort: 1}
 }
 
-func StableCascade_SuperResolutionControlnet(gr *Graph, image IMAGE, vae VAE) (_ *Node, controlnet_input IMAGE, stage_c LATENT, stage_b LATENT) {
+func StableCascade_SuperResolutionControlnet(gr *Graph, image IMAGE, vae VAE, opts ...NodeOption) (_ *Node, controlnet_input IMAGE, stage_c LATENT, stage_b LATENT) {
 	nd := &Node{
 		Class: "StableCascade_SuperResolutionControlnet",
 		Inputs: map[string]Value{
@@ -16696,11 +17219,12 @@ func StableCascade_SuperResolutionControlnet(gr *Graph, image IMAGE, vae VAE) (_
 			"vae":   Link(vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}, LATENT{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func StableZero123_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, batch_size int, elevation, azimuth float64) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
+func StableZero123_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, batch_size int, elevation, azimuth float64, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "StableZero123_Conditioning",
 		Inputs: map[string]Value{
@@ -16714,11 +17238,12 @@ func StableZero123_Conditioning(gr *Graph, clip_vision CLIP_VISION, init_image I
 			"azimuth":     Float(azimuth),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func StableZero123_Conditioning_Batched(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, batch_size int, elevation, azimuth, elevation_batch_increment, azimuth_batch_increment float64) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
+func StableZero123_Conditioning_Batched(gr *Graph, clip_vision CLIP_VISION, init_image IMAGE, vae VAE, width, height, batch_size int, elevation, azimuth, elevation_batch_increment, azimuth_batch_increment float64, opts ...NodeOption) (_ *Node, positive CONDITIONING, negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "StableZero123_Conditioning_Batched",
 		Inputs: map[string]Value{
@@ -16734,12 +17259,13 @@ func StableZero123_Conditioning_Batched(gr *Graph, clip_vision CLIP_VISION, init
 			"azimuth_batch_increment":   Float(azimuth_batch_increment),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // StringCompare - Compare
-func StringCompare(gr *Graph, string_a, string_b string, mode string, case_sensitive bool) (_ *Node, boolean BOOLEAN) {
+func StringCompare(gr *Graph, string_a, string_b string, mode string, case_sensitive bool, opts ...NodeOption) (_ *Node, boolean BOOLEAN) {
 	nd := &Node{
 		Class: "StringCompare",
 		Inputs: map[string]Value{
@@ -16749,12 +17275,13 @@ func StringCompare(gr *Graph, string_a, string_b string, mode string, case_sensi
 			"case_sensitive": Bool(case_sensitive),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, BOOLEAN{NodeID: id, OutPort: 0}
 }
 
 // StringConcatenate - Concatenate
-func StringConcatenate(gr *Graph, string_a, string_b, delimiter string) (_ *Node, str STRING) {
+func StringConcatenate(gr *Graph, string_a, string_b, delimiter string, opts ...NodeOption) (_ *Node, str STRING) {
 	nd := &Node{
 		Class: "StringConcatenate",
 		Inputs: map[string]Value{
@@ -16763,12 +17290,13 @@ func StringConcatenate(gr *Graph, string_a, string_b, delimiter string) (_ *Node
 			"delimiter": String(delimiter),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // StringContains - Contains
-func StringContains(gr *Graph, str, substring string, case_sensitive bool) (_ *Node, contains BOOLEAN) {
+func StringContains(gr *Graph, str, substring string, case_sensitive bool, opts ...NodeOption) (_ *Node, contains BOOLEAN) {
 	nd := &Node{
 		Class: "StringContains",
 		Inputs: map[string]Value{
@@ -16777,24 +17305,26 @@ func StringContains(gr *Graph, str, substring string, case_sensitive bool) (_ *N
 			"case_sensitive": Bool(case_sensitive),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, BOOLEAN{NodeID: id, OutPort: 0}
 }
 
 // StringLength - Length
-func StringLength(gr *Graph, str string) (_ *Node, length INT) {
+func StringLength(gr *Graph, str string, opts ...NodeOption) (_ *Node, length INT) {
 	nd := &Node{
 		Class: "StringLength",
 		Inputs: map[string]Value{
 			"string": String(str),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, INT{NodeID: id, OutPort: 0}
 }
 
 // StringReplace - Replace
-func StringReplace(gr *Graph, str, find, replace string) (_ *Node, out_str STRING) {
+func StringReplace(gr *Graph, str, find, replace string, opts ...NodeOption) (_ *Node, out_str STRING) {
 	nd := &Node{
 		Class: "StringReplace",
 		Inputs: map[string]Value{
@@ -16803,12 +17333,13 @@ func StringReplace(gr *Graph, str, find, replace string) (_ *Node, out_str STRIN
 			"replace": String(replace),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // StringSubstring - Substring
-func StringSubstring(gr *Graph, str string, start, end int) (_ *Node, out_str STRING) {
+func StringSubstring(gr *Graph, str string, start, end int, opts ...NodeOption) (_ *Node, out_str STRING) {
 	nd := &Node{
 		Class: "StringSubstring",
 		Inputs: map[string]Value{
@@ -16817,12 +17348,13 @@ func StringSubstring(gr *Graph, str string, start, end int) (_ *Node, out_str ST
 			"end":    Int(end),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // StringTrim - Trim
-func StringTrim(gr *Graph, str string, mode string) (_ *Node, out_str STRING) {
+func StringTrim(gr *Graph, str string, mode string, opts ...NodeOption) (_ *Node, out_str STRING) {
 	nd := &Node{
 		Class: "StringTrim",
 		Inputs: map[string]Value{
@@ -16830,24 +17362,26 @@ func StringTrim(gr *Graph, str string, mode string) (_ *Node, out_str STRING) {
 			"mode":   String(mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // StripWhitespace - Strip Whitespace
-func StripWhitespace(gr *Graph, texts string) (_ *Node, out_texts STRING) {
+func StripWhitespace(gr *Graph, texts string, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "StripWhitespace",
 		Inputs: map[string]Value{
 			"texts": String(texts),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // StyleModelApply - Apply Style Model
-func StyleModelApply(gr *Graph, conditioning CONDITIONING, style_model STYLE_MODEL, clip_vision_output CLIP_VISION_OUTPUT, strength float64, strength_type string) (_ *Node, out_conditioning CONDITIONING) {
+func StyleModelApply(gr *Graph, conditioning CONDITIONING, style_model STYLE_MODEL, clip_vision_output CLIP_VISION_OUTPUT, strength float64, strength_type string, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "StyleModelApply",
 		Inputs: map[string]Value{
@@ -16858,23 +17392,25 @@ func StyleModelApply(gr *Graph, conditioning CONDITIONING, style_model STYLE_MOD
 			"strength_type":      String(strength_type),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // StyleModelLoader - Load Style Model
-func StyleModelLoader(gr *Graph, style_model_name string) (_ *Node, style_model STYLE_MODEL) {
+func StyleModelLoader(gr *Graph, style_model_name string, opts ...NodeOption) (_ *Node, style_model STYLE_MODEL) {
 	nd := &Node{
 		Class: "StyleModelLoader",
 		Inputs: map[string]Value{
 			"style_model_name": String(style_model_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STYLE_MODEL{NodeID: id, OutPort: 0}
 }
 
-func T5TokenizerOptions(gr *Graph, clip CLIP, min_padding, min_length int) (_ *Node, out_clip CLIP) {
+func T5TokenizerOptions(gr *Graph, clip CLIP, min_padding, min_length int, opts ...NodeOption) (_ *Node, out_clip CLIP) {
 	nd := &Node{
 		Class: "T5TokenizerOptions",
 		Inputs: map[string]Value{
@@ -16883,24 +17419,26 @@ func T5TokenizerOptions(gr *Graph, clip CLIP, min_padding, min_length int) (_ *N
 			"min_length":  Int(min_length),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
 // TCFG - Tangential Damping CFG
-func TCFG(gr *Graph, model MODEL) (_ *Node, patched_model MODEL) {
+func TCFG(gr *Graph, model MODEL, opts ...NodeOption) (_ *Node, patched_model MODEL) {
 	nd := &Node{
 		Class: "TCFG",
 		Inputs: map[string]Value{
 			"model": Link(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // TemporalScoreRescaling - TSR - Temporal Score Rescaling
-func TemporalScoreRescaling(gr *Graph, model MODEL, tsr_k, tsr_sigma float64) (_ *Node, patched_model MODEL) {
+func TemporalScoreRescaling(gr *Graph, model MODEL, tsr_k, tsr_sigma float64, opts ...NodeOption) (_ *Node, patched_model MODEL) {
 	nd := &Node{
 		Class: "TemporalScoreRescaling",
 		Inputs: map[string]Value{
@@ -16909,11 +17447,12 @@ func TemporalScoreRescaling(gr *Graph, model MODEL, tsr_k, tsr_sigma float64) (_
 			"tsr_sigma": Float(tsr_sigma),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func TextEncodeAceStepAudio(gr *Graph, clip CLIP, tags, lyrics string, lyrics_strength float64) (_ *Node, conditioning CONDITIONING) {
+func TextEncodeAceStepAudio(gr *Graph, clip CLIP, tags, lyrics string, lyrics_strength float64, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "TextEncodeAceStepAudio",
 		Inputs: map[string]Value{
@@ -16923,11 +17462,12 @@ func TextEncodeAceStepAudio(gr *Graph, clip CLIP, tags, lyrics string, lyrics_st
 			"lyrics_strength": Float(lyrics_strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func TextEncodeHunyuanVideo_ImageToVideo(gr *Graph, clip CLIP, clip_vision_output CLIP_VISION_OUTPUT, prompt string, image_interleave int) (_ *Node, conditioning CONDITIONING) {
+func TextEncodeHunyuanVideo_ImageToVideo(gr *Graph, clip CLIP, clip_vision_output CLIP_VISION_OUTPUT, prompt string, image_interleave int, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "TextEncodeHunyuanVideo_ImageToVideo",
 		Inputs: map[string]Value{
@@ -16937,11 +17477,12 @@ func TextEncodeHunyuanVideo_ImageToVideo(gr *Graph, clip CLIP, clip_vision_outpu
 			"image_interleave":   Int(image_interleave),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func TextEncodeQwenImageEdit(gr *Graph, clip CLIP, vae VAE, image IMAGE, prompt string) (_ *Node, conditioning CONDITIONING) {
+func TextEncodeQwenImageEdit(gr *Graph, clip CLIP, vae VAE, image IMAGE, prompt string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "TextEncodeQwenImageEdit",
 		Inputs: map[string]Value{
@@ -16949,11 +17490,12 @@ func TextEncodeQwenImageEdit(gr *Graph, clip CLIP, vae VAE, image IMAGE, prompt
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func TextEncodeQwenImageEditPlus(gr *Graph, clip CLIP, vae VAE, image1 IMAGE, image2 IMAGE, image3 IMAGE, prompt string) (_ *Node, conditioning CONDITIONING) {
+func TextEncodeQwenImageEditPlus(gr *Graph, clip CLIP, vae VAE, image1 IMAGE, image2 IMAGE, image3 IMAGE, prompt string, opts ...NodeOption) (_ *Node, conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "TextEncodeQwenImageEditPlus",
 		Inputs: map[string]Value{
@@ -16961,35 +17503,38 @@ func TextEncodeQwenImageEditPlus(gr *Graph, clip CLIP, vae VAE, image1 IMAGE, im
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
 // TextToLowercase - Text to Lowercase
-func TextToLowercase(gr *Graph, texts string) (_ *Node, out_texts STRING) {
+func TextToLowercase(gr *Graph, texts string, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "TextToLowercase",
 		Inputs: map[string]Value{
 			"texts": String(texts),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // TextToUppercase - Text to Uppercase
-func TextToUppercase(gr *Graph, texts string) (_ *Node, out_texts STRING) {
+func TextToUppercase(gr *Graph, texts string, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "TextToUppercase",
 		Inputs: map[string]Value{
 			"texts": String(texts),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
-func ThresholdMask(gr *Graph, mask MASK, value float64) (_ *Node, out_mask MASK) {
+func ThresholdMask(gr *Graph, mask MASK, value float64, opts ...NodeOption) (_ *Node, out_mask MASK) {
 	nd := &Node{
 		Class: "ThresholdMask",
 		Inputs: map[string]Value{
@@ -16997,11 +17542,12 @@ func ThresholdMask(gr *Graph, mask MASK, value float64) (_ *Node, out_mask MASK)
 			"value": Float(value),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MASK{NodeID: id, OutPort: 0}
 }
 
-func TomePatchModel(gr *Graph, model MODEL, ratio float64) (_ *Node, out_model MODEL) {
+func TomePatchModel(gr *Graph, model MODEL, ratio float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "TomePatchModel",
 		Inputs: map[string]Value{
@@ -17009,12 +17555,13 @@ func TomePatchModel(gr *Graph, model MODEL, ratio float64) (_ *Node, out_model M
 			"ratio": Float(ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // TopazImageEnhance - Topaz Image Enhance
-func TopazImageEnhance(gr *Graph, image IMAGE, model string, prompt string, subject_detection string, face_enhancement bool, face_enhancement_creativity, face_enhancement_strength float64, crop_to_fill bool, output_width, output_height, creativity int, face_preservation, color_preservation bool) (_ *Node, out_image IMAGE) {
+func TopazImageEnhance(gr *Graph, image IMAGE, model string, prompt string, subject_detection string, face_enhancement bool, face_enhancement_creativity, face_enhancement_strength float64, crop_to_fill bool, output_width, output_height, creativity int, face_preservation, color_preservation bool, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "TopazImageEnhance",
 		Inputs: map[string]Value{
@@ -17022,12 +17569,13 @@ func TopazImageEnhance(gr *Graph, image IMAGE, model string, prompt string, subj
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // TopazVideoEnhance - Topaz Video Enhance
-func TopazVideoEnhance(gr *Graph, video VIDEO, upscaler_enabled bool, upscaler_model, upscaler_resolution, upscaler_creativity string, interpolation_enabled bool, interpolation_model string, interpolation_slowmo, interpolation_frame_rate int, interpolation_duplicate bool, interpolation_duplicate_threshold float64, dynamic_compression_level string) (_ *Node, out_video VIDEO) {
+func TopazVideoEnhance(gr *Graph, video VIDEO, upscaler_enabled bool, upscaler_model, upscaler_resolution, upscaler_creativity string, interpolation_enabled bool, interpolation_model string, interpolation_slowmo, interpolation_frame_rate int, interpolation_duplicate bool, interpolation_duplicate_threshold float64, dynamic_compression_level string, opts ...NodeOption) (_ *Node, out_video VIDEO) {
 	nd := &Node{
 		Class: "TopazVideoEnhance",
 		Inputs: map[string]Value{
@@ -17037,11 +17585,12 @@ func TopazVideoEnhance(gr *Graph, video VIDEO, upscaler_enabled bool, upscaler_m
 			"upscaler_resolution": String(upscaler_resolution),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func TorchCompileModel(gr *Graph, model MODEL, backend string) (_ *Node, out_model MODEL) {
+func TorchCompileModel(gr *Graph, model MODEL, backend string, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "TorchCompileModel",
 		Inputs: map[string]Value{
@@ -17049,12 +17598,13 @@ func TorchCompileModel(gr *Graph, model MODEL, backend string) (_ *Node, out_mod
 			"backend": String(backend),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // TrainLoraNode - Train LoRA
-func TrainLoraNode(gr *Graph, model MODEL, latents LATENT, positive CONDITIONING, batch_size, grad_accumulation_steps, steps int, learning_rate float64, rank int, optimizer, loss_function string, seed int, training_dtype, lora_dtype, algorithm string, gradient_checkpointing bool, existing_lora string, bucket_mode bool) (_ *Node, out_model MODEL, lora LORA_MODEL, loss_map LOSS_MAP, out_steps INT) {
+func TrainLoraNode(gr *Graph, model MODEL, latents LATENT, positive CONDITIONING, batch_size, grad_accumulation_steps, steps int, learning_rate float64, rank int, optimizer, loss_function string, seed int, training_dtype, lora_dtype, algorithm string, gradient_checkpointing bool, existing_lora string, bucket_mode bool, opts ...NodeOption) (_ *Node, out_model MODEL, lora LORA_MODEL, loss_map LOSS_MAP, out_steps INT) {
 	nd := &Node{
 		Class: "TrainLoraNode",
 		Inputs: map[string]Value{
@@ -17077,12 +17627,13 @@ func TrainLoraNode(gr *Graph, model MODEL, latents LATENT, positive CONDITIONING
 			"bucket_mode":             Bool(bucket_mode),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}, LORA_MODEL{NodeID: id, OutPort: 1}, LOSS_MAP{NodeID: id, OutPort: 2}, INT{NodeID: id, OutPort: 3}
 }
 
 // TrimAudioDuration - Trim Audio Duration
-func TrimAudioDuration(gr *Graph, audio AUDIO, start_index, duration float64) (_ *Node, out_audio AUDIO) {
+func TrimAudioDuration(gr *Graph, audio AUDIO, start_index, duration float64, opts ...NodeOption) (_ *Node, out_audio AUDIO) {
 	nd := &Node{
 		Class: "TrimAudioDuration",
 		Inputs: map[string]Value{
@@ -17091,11 +17642,12 @@ func TrimAudioDuration(gr *Graph, audio AUDIO, start_index, duration float64) (_
 			"duration":    Float(duration),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
-func TrimVideoLatent(gr *Graph, samples LATENT, trim_amount int) (_ *Node, latent LATENT) {
+func TrimVideoLatent(gr *Graph, samples LATENT, trim_amount int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "TrimVideoLatent",
 		Inputs: map[string]Value{
@@ -17103,11 +17655,12 @@ func TrimVideoLatent(gr *Graph, samples LATENT, trim_amount int) (_ *Node, laten
 			"trim_amount": Int(trim_amount),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func TripleCLIPLoader(gr *Graph, clip_name1, clip_name2, clip_name3 string) (_ *Node, clip CLIP) {
+func TripleCLIPLoader(gr *Graph, clip_name1, clip_name2, clip_name3 string, opts ...NodeOption) (_ *Node, clip CLIP) {
 	nd := &Node{
 		Class: "TripleCLIPLoader",
 		Inputs: map[string]Value{
@@ -17116,12 +17669,13 @@ func TripleCLIPLoader(gr *Graph, clip_name1, clip_name2, clip_name3 string) (_ *
 			"clip_name3": String(clip_name3),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CLIP{NodeID: id, OutPort: 0}
 }
 
 // TripoConversionNode - Tripo: Convert model
-func TripoConversionNode(gr *Graph, original_model_task_id MODEL_TASK_ID, RIG_TASK_ID, RETARGET_TASK_ID, format string, quad bool, face_limit, texture_size int, texture_format string, force_symmetry, flatten_bottom bool, flatten_bottom_threshold float64, pivot_to_center_bottom bool, scale_factor float64, with_animation, pack_uv, bake bool, part_names string, fbx_preset string, export_vertex_colors bool, export_orientation string, animate_in_place bool) (_ *Node) {
+func TripoConversionNode(gr *Graph, original_model_task_id MODEL_TASK_ID, RIG_TASK_ID, RETARGET_TASK_ID, format string, quad bool, face_limit, texture_size int, texture_format string, force_symmetry, flatten_bottom bool, flatten_bottom_threshold float64, pivot_to_center_bottom bool, scale_factor float64, with_animation, pack_uv, bake bool, part_names string, fbx_preset string, export_vertex_colors bool, export_orientation string, animate_in_place bool, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "TripoConversionNode",
 		Inputs: map[string]Value{
@@ -17129,48 +17683,52 @@ func TripoConversionNode(gr *Graph, original_model_task_id MODEL_TASK_ID, RIG_TA
 			"format":                 String(format),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
 // TripoImageToModelNode - Tripo: Image to Model
-func TripoImageToModelNode(gr *Graph, image IMAGE, model_version, style string, texture, pbr bool, model_seed int, orientation string, texture_seed int, texture_quality, texture_alignment string, face_limit int, quad bool, geometry_quality string) (_ *Node, model_file STRING, model_task_id MODEL_TASK_ID) {
+func TripoImageToModelNode(gr *Graph, image IMAGE, model_version, style string, texture, pbr bool, model_seed int, orientation string, texture_seed int, texture_quality, texture_alignment string, face_limit int, quad bool, geometry_quality string, opts ...NodeOption) (_ *Node, model_file STRING, model_task_id MODEL_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "TripoImageToModelNode",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // TripoMultiviewToModelNode - Tripo: Multiview to Model
-func TripoMultiviewToModelNode(gr *Graph, image IMAGE, image_left IMAGE, image_back IMAGE, image_right IMAGE, model_version, orientation string, texture, pbr bool, model_seed, texture_seed int, texture_quality, texture_alignment string, face_limit int, quad bool, geometry_quality string) (_ *Node, model_file STRING, model_task_id MODEL_TASK_ID) {
+func TripoMultiviewToModelNode(gr *Graph, image IMAGE, image_left IMAGE, image_back IMAGE, image_right IMAGE, model_version, orientation string, texture, pbr bool, model_seed, texture_seed int, texture_quality, texture_alignment string, face_limit int, quad bool, geometry_quality string, opts ...NodeOption) (_ *Node, model_file STRING, model_task_id MODEL_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "TripoMultiviewToModelNode",
 		Inputs: map[string]Value{
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // TripoRefineNode - Tripo: Refine Draft model
-func TripoRefineNode(gr *Graph, model_task_id MODEL_TASK_ID) (_ *Node, model_file STRING, out_model_task_id MODEL_TASK_ID) {
+func TripoRefineNode(gr *Graph, model_task_id MODEL_TASK_ID, opts ...NodeOption) (_ *Node, model_file STRING, out_model_task_id MODEL_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "TripoRefineNode",
 		Inputs: map[string]Value{
 			"model_task_id": Link(model_task_id),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // TripoRetargetNode - Tripo: Retarget rigged model
-func TripoRetargetNode(gr *Graph, original_model_task_id RIG_TASK_ID, animation string) (_ *Node, model_file STRING, retarget_task_id RETARGET_TASK_ID) {
+func TripoRetargetNode(gr *Graph, original_model_task_id RIG_TASK_ID, animation string, opts ...NodeOption) (_ *Node, model_file STRING, retarget_task_id RETARGET_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "TripoRetargetNode",
 		Inputs: map[string]Value{
@@ -17178,48 +17736,52 @@ func TripoRetargetNode(gr *Graph, original_model_task_id RIG_TASK_ID, animation
 			"animation":              String(animation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, RETARGET_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, RETARGET_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // TripoRigNode - Tripo: Rig model
-func TripoRigNode(gr *Graph, original_model_task_id MODEL_TASK_ID) (_ *Node, model_file STRING, rig_task_id RIG_TASK_ID) {
+func TripoRigNode(gr *Graph, original_model_task_id MODEL_TASK_ID, opts ...NodeOption) (_ *Node, model_file STRING, rig_task_id RIG_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "TripoRigNode",
 		Inputs: map[string]Value{
 			"original_model_task_id": Link(original_model_task_id),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, RIG_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, RIG_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // TripoTextToModelNode - Tripo: Text to Model
-func TripoTextToModelNode(gr *Graph, prompt, negative_prompt string, model_version, style string, texture, pbr bool, image_seed, model_seed, texture_seed int, texture_quality string, face_limit int, quad bool, geometry_quality string) (_ *Node, model_file STRING, model_task_id MODEL_TASK_ID) {
+func TripoTextToModelNode(gr *Graph, prompt, negative_prompt string, model_version, style string, texture, pbr bool, image_seed, model_seed, texture_seed int, texture_quality string, face_limit int, quad bool, geometry_quality string, opts ...NodeOption) (_ *Node, model_file STRING, model_task_id MODEL_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "TripoTextToModelNode",
 		Inputs: map[string]Value{
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // TripoTextureNode - Tripo: Texture model
-func TripoTextureNode(gr *Graph, model_task_id MODEL_TASK_ID, texture, pbr bool, texture_seed int, texture_quality, texture_alignment string) (_ *Node, model_file STRING, out_model_task_id MODEL_TASK_ID) {
+func TripoTextureNode(gr *Graph, model_task_id MODEL_TASK_ID, texture, pbr bool, texture_seed int, texture_quality, texture_alignment string, opts ...NodeOption) (_ *Node, model_file STRING, out_model_task_id MODEL_TASK_ID, _ Output) {
 	nd := &Node{
 		Class: "TripoTextureNode",
 		Inputs: map[string]Value{
 			"model_task_id": Link(model_task_id),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
-	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}
+	return nd, STRING{NodeID: id, OutPort: 0}, MODEL_TASK_ID{NodeID: id, OutPort: 1}, Output{NodeID: id}
 }
 
 // TruncateText - Truncate Text
-func TruncateText(gr *Graph, texts string, max_length int) (_ *Node, out_texts STRING) {
+func TruncateText(gr *Graph, texts string, max_length int, opts ...NodeOption) (_ *Node, out_texts STRING) {
 	nd := &Node{
 		Class: "TruncateText",
 		Inputs: map[string]Value{
@@ -17227,12 +17789,13 @@ func TruncateText(gr *Graph, texts string, max_length int) (_ *Node, out_texts S
 			"max_length": Int(max_length),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, STRING{NodeID: id, OutPort: 0}
 }
 
 // UNETLoader - Load Diffusion Model
-func UNETLoader(gr *Graph, unet_name, weight_dtype string) (_ *Node, model MODEL) {
+func UNETLoader(gr *Graph, unet_name, weight_dtype string, opts ...NodeOption) (_ *Node, model MODEL) {
 	nd := &Node{
 		Class: "UNETLoader",
 		Inputs: map[string]Value{
@@ -17240,11 +17803,12 @@ func UNETLoader(gr *Graph, unet_name, weight_dtype string) (_ *Node, model MODEL
 			"weight_dtype": String(weight_dtype),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func UNetCrossAttentionMultiply(gr *Graph, model MODEL, q, k, v, out float64) (_ *Node, out_model MODEL) {
+func UNetCrossAttentionMultiply(gr *Graph, model MODEL, q, k, v, out float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "UNetCrossAttentionMultiply",
 		Inputs: map[string]Value{
@@ -17255,11 +17819,12 @@ func UNetCrossAttentionMultiply(gr *Graph, model MODEL, q, k, v, out float64) (_
 			"out":   Float(out),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func UNetSelfAttentionMultiply(gr *Graph, model MODEL, q, k, v, out float64) (_ *Node, out_model MODEL) {
+func UNetSelfAttentionMultiply(gr *Graph, model MODEL, q, k, v, out float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "UNetSelfAttentionMultiply",
 		Inputs: map[string]Value{
@@ -17270,11 +17835,12 @@ func UNetSelfAttentionMultiply(gr *Graph, model MODEL, q, k, v, out float64) (_
 			"out":   Float(out),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func UNetTemporalAttentionMultiply(gr *Graph, model MODEL, self_structural, self_temporal, cross_structural, cross_temporal float64) (_ *Node, out_model MODEL) {
+func UNetTemporalAttentionMultiply(gr *Graph, model MODEL, self_structural, self_temporal, cross_structural, cross_temporal float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "UNetTemporalAttentionMultiply",
 		Inputs: map[string]Value{
@@ -17285,11 +17851,12 @@ func UNetTemporalAttentionMultiply(gr *Graph, model MODEL, self_structural, self
 			"cross_temporal":   Float(cross_temporal),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func USOStyleReference(gr *Graph, model MODEL, model_patch MODEL_PATCH, clip_vision_output CLIP_VISION_OUTPUT) (_ *Node, out_model MODEL) {
+func USOStyleReference(gr *Graph, model MODEL, model_patch MODEL_PATCH, clip_vision_output CLIP_VISION_OUTPUT, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "USOStyleReference",
 		Inputs: map[string]Value{
@@ -17298,24 +17865,26 @@ func USOStyleReference(gr *Graph, model MODEL, model_patch MODEL_PATCH, clip_vis
 			"clip_vision_output": Link(clip_vision_output),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // UpscaleModelLoader - Load Upscale Model
-func UpscaleModelLoader(gr *Graph, model_name string) (_ *Node, upscale_model UPSCALE_MODEL) {
+func UpscaleModelLoader(gr *Graph, model_name string, opts ...NodeOption) (_ *Node, upscale_model UPSCALE_MODEL) {
 	nd := &Node{
 		Class: "UpscaleModelLoader",
 		Inputs: map[string]Value{
 			"model_name": String(model_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, UPSCALE_MODEL{NodeID: id, OutPort: 0}
 }
 
 // VAEDecode - VAE Decode
-func VAEDecode(gr *Graph, samples LATENT, vae VAE) (_ *Node, image IMAGE) {
+func VAEDecode(gr *Graph, samples LATENT, vae VAE, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "VAEDecode",
 		Inputs: map[string]Value{
@@ -17323,12 +17892,13 @@ func VAEDecode(gr *Graph, samples LATENT, vae VAE) (_ *Node, image IMAGE) {
 			"vae":     Link(vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // VAEDecodeAudio - VAE Decode Audio
-func VAEDecodeAudio(gr *Graph, samples LATENT, vae VAE) (_ *Node, audio AUDIO) {
+func VAEDecodeAudio(gr *Graph, samples LATENT, vae VAE, opts ...NodeOption) (_ *Node, audio AUDIO) {
 	nd := &Node{
 		Class: "VAEDecodeAudio",
 		Inputs: map[string]Value{
@@ -17336,11 +17906,12 @@ func VAEDecodeAudio(gr *Graph, samples LATENT, vae VAE) (_ *Node, audio AUDIO) {
 			"vae":     Link(vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, AUDIO{NodeID: id, OutPort: 0}
 }
 
-func VAEDecodeHunyuan3D(gr *Graph, samples LATENT, vae VAE, num_chunks, octree_resolution int) (_ *Node, voxel VOXEL) {
+func VAEDecodeHunyuan3D(gr *Graph, samples LATENT, vae VAE, num_chunks, octree_resolution int, opts ...NodeOption) (_ *Node, voxel VOXEL) {
 	nd := &Node{
 		Class: "VAEDecodeHunyuan3D",
 		Inputs: map[string]Value{
@@ -17350,12 +17921,13 @@ func VAEDecodeHunyuan3D(gr *Graph, samples LATENT, vae VAE, num_chunks, octree_r
 			"octree_resolution": Int(octree_resolution),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VOXEL{NodeID: id, OutPort: 0}
 }
 
 // VAEDecodeTiled - VAE Decode (Tiled)
-func VAEDecodeTiled(gr *Graph, samples LATENT, vae VAE, tile_size, overlap, temporal_size, temporal_overlap int) (_ *Node, image IMAGE) {
+func VAEDecodeTiled(gr *Graph, samples LATENT, vae VAE, tile_size, overlap, temporal_size, temporal_overlap int, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "VAEDecodeTiled",
 		Inputs: map[string]Value{
@@ -17367,12 +17939,13 @@ func VAEDecodeTiled(gr *Graph, samples LATENT, vae VAE, tile_size, overlap, temp
 			"temporal_overlap": Int(temporal_overlap),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // VAEEncode - VAE Encode
-func VAEEncode(gr *Graph, pixels IMAGE, vae VAE) (_ *Node, latent LATENT) {
+func VAEEncode(gr *Graph, pixels IMAGE, vae VAE, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "VAEEncode",
 		Inputs: map[string]Value{
@@ -17380,12 +17953,13 @@ func VAEEncode(gr *Graph, pixels IMAGE, vae VAE) (_ *Node, latent LATENT) {
 			"vae":    Link(vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // VAEEncodeAudio - VAE Encode Audio
-func VAEEncodeAudio(gr *Graph, audio AUDIO, vae VAE) (_ *Node, latent LATENT) {
+func VAEEncodeAudio(gr *Graph, audio AUDIO, vae VAE, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "VAEEncodeAudio",
 		Inputs: map[string]Value{
@@ -17393,12 +17967,13 @@ func VAEEncodeAudio(gr *Graph, audio AUDIO, vae VAE) (_ *Node, latent LATENT) {
 			"vae":   Link(vae),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // VAEEncodeForInpaint - VAE Encode (for Inpainting)
-func VAEEncodeForInpaint(gr *Graph, pixels IMAGE, vae VAE, mask MASK, grow_mask_by int) (_ *Node, latent LATENT) {
+func VAEEncodeForInpaint(gr *Graph, pixels IMAGE, vae VAE, mask MASK, grow_mask_by int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "VAEEncodeForInpaint",
 		Inputs: map[string]Value{
@@ -17408,12 +17983,13 @@ func VAEEncodeForInpaint(gr *Graph, pixels IMAGE, vae VAE, mask MASK, grow_mask_
 			"grow_mask_by": Int(grow_mask_by),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // VAEEncodeTiled - VAE Encode (Tiled)
-func VAEEncodeTiled(gr *Graph, pixels IMAGE, vae VAE, tile_size, overlap, temporal_size, temporal_overlap int) (_ *Node, latent LATENT) {
+func VAEEncodeTiled(gr *Graph, pixels IMAGE, vae VAE, tile_size, overlap, temporal_size, temporal_overlap int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "VAEEncodeTiled",
 		Inputs: map[string]Value{
@@ -17425,23 +18001,25 @@ func VAEEncodeTiled(gr *Graph, pixels IMAGE, vae VAE, tile_size, overlap, tempor
 			"temporal_overlap": Int(temporal_overlap),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
 // VAELoader - Load VAE
-func VAELoader(gr *Graph, vae_name string) (_ *Node, vae VAE) {
+func VAELoader(gr *Graph, vae_name string, opts ...NodeOption) (_ *Node, vae VAE) {
 	nd := &Node{
 		Class: "VAELoader",
 		Inputs: map[string]Value{
 			"vae_name": String(vae_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VAE{NodeID: id, OutPort: 0}
 }
 
-func VAESave(gr *Graph, vae VAE, filename_prefix string) (_ *Node) {
+func VAESave(gr *Graph, vae VAE, filename_prefix string, opts ...NodeOption) (_ *Node, _ Output) {
 	nd := &Node{
 		Class: "VAESave",
 		Inputs: map[string]Value{
@@ -17449,11 +18027,12 @@ func VAESave(gr *Graph, vae VAE, filename_prefix string) (_ *Node) {
 			"filename_prefix": String(filename_prefix),
 		},
 	}
-	gr.Add(nd)
-	return nd
+	applyNodeOptions(nd, opts)
+	id := gr.Add(nd)
+	return nd, Output{NodeID: id}
 }
 
-func VPScheduler(gr *Graph, steps int, beta_d, beta_min, eps_s float64) (_ *Node, sigmas SIGMAS) {
+func VPScheduler(gr *Graph, steps int, beta_d, beta_min, eps_s float64, opts ...NodeOption) (_ *Node, sigmas SIGMAS) {
 	nd := &Node{
 		Class: "VPScheduler",
 		Inputs: map[string]Value{
@@ -17463,12 +18042,13 @@ func VPScheduler(gr *Graph, steps int, beta_d, beta_min, eps_s float64) (_ *Node
 			"eps_s":    Float(eps_s),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, SIGMAS{NodeID: id, OutPort: 0}
 }
 
 // Veo3FirstLastFrameNode - Google Veo 3 First-Last-Frame to Video
-func Veo3FirstLastFrameNode(gr *Graph, first_frame IMAGE, last_frame IMAGE, prompt, negative_prompt string, resolution, aspect_ratio string, duration, seed int, model string, generate_audio bool) (_ *Node, video VIDEO) {
+func Veo3FirstLastFrameNode(gr *Graph, first_frame IMAGE, last_frame IMAGE, prompt, negative_prompt string, resolution, aspect_ratio string, duration, seed int, model string, generate_audio bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "Veo3FirstLastFrameNode",
 		Inputs: map[string]Value{
@@ -17484,12 +18064,13 @@ func Veo3FirstLastFrameNode(gr *Graph, first_frame IMAGE, last_frame IMAGE, prom
 			"generate_audio":  Bool(generate_audio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // Veo3VideoGenerationNode - Google Veo 3 Video Generation
-func Veo3VideoGenerationNode(gr *Graph, image IMAGE, prompt string, aspect_ratio string, negative_prompt string, duration_seconds int, enhance_prompt bool, person_generation string, seed int, model string, generate_audio bool) (_ *Node, video VIDEO) {
+func Veo3VideoGenerationNode(gr *Graph, image IMAGE, prompt string, aspect_ratio string, negative_prompt string, duration_seconds int, enhance_prompt bool, person_generation string, seed int, model string, generate_audio bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "Veo3VideoGenerationNode",
 		Inputs: map[string]Value{
@@ -17497,12 +18078,13 @@ func Veo3VideoGenerationNode(gr *Graph, image IMAGE, prompt string, aspect_ratio
 			"aspect_ratio": String(aspect_ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // VeoVideoGenerationNode - Google Veo 2 Video Generation
-func VeoVideoGenerationNode(gr *Graph, image IMAGE, prompt string, aspect_ratio string, negative_prompt string, duration_seconds int, enhance_prompt bool, person_generation string, seed int, model string) (_ *Node, video VIDEO) {
+func VeoVideoGenerationNode(gr *Graph, image IMAGE, prompt string, aspect_ratio string, negative_prompt string, duration_seconds int, enhance_prompt bool, person_generation string, seed int, model string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "VeoVideoGenerationNode",
 		Inputs: map[string]Value{
@@ -17510,11 +18092,12 @@ func VeoVideoGenerationNode(gr *Graph, image IMAGE, prompt string, aspect_ratio
 			"aspect_ratio": String(aspect_ratio),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func VideoLinearCFGGuidance(gr *Graph, model MODEL, min_cfg float64) (_ *Node, out_model MODEL) {
+func VideoLinearCFGGuidance(gr *Graph, model MODEL, min_cfg float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "VideoLinearCFGGuidance",
 		Inputs: map[string]Value{
@@ -17522,11 +18105,12 @@ func VideoLinearCFGGuidance(gr *Graph, model MODEL, min_cfg float64) (_ *Node, o
 			"min_cfg": Float(min_cfg),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func VideoTriangleCFGGuidance(gr *Graph, model MODEL, min_cfg float64) (_ *Node, out_model MODEL) {
+func VideoTriangleCFGGuidance(gr *Graph, model MODEL, min_cfg float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "VideoTriangleCFGGuidance",
 		Inputs: map[string]Value{
@@ -17534,12 +18118,13 @@ func VideoTriangleCFGGuidance(gr *Graph, model MODEL, min_cfg float64) (_ *Node,
 			"min_cfg": Float(min_cfg),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // Vidu2ImageToVideoNode - Vidu2 Image-to-Video Generation
-func Vidu2ImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string) (_ *Node, video VIDEO) {
+func Vidu2ImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "Vidu2ImageToVideoNode",
 		Inputs: map[string]Value{
@@ -17552,12 +18137,13 @@ func Vidu2ImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string,
 			"movement_amplitude": String(movement_amplitude),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // Vidu2ReferenceVideoNode - Vidu2 Reference-to-Video Generation
-func Vidu2ReferenceVideoNode(gr *Graph, subjects COMFY_AUTOGROW_V3, model string, prompt string, audio bool, duration, seed int, aspect_ratio, resolution, movement_amplitude string) (_ *Node, video VIDEO) {
+func Vidu2ReferenceVideoNode(gr *Graph, subjects COMFY_AUTOGROW_V3, model string, prompt string, audio bool, duration, seed int, aspect_ratio, resolution, movement_amplitude string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "Vidu2ReferenceVideoNode",
 		Inputs: map[string]Value{
@@ -17572,12 +18158,13 @@ func Vidu2ReferenceVideoNode(gr *Graph, subjects COMFY_AUTOGROW_V3, model string
 			"movement_amplitude": String(movement_amplitude),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // Vidu2StartEndToVideoNode - Vidu2 Start/End Frame-to-Video Generation
-func Vidu2StartEndToVideoNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string) (_ *Node, video VIDEO) {
+func Vidu2StartEndToVideoNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "Vidu2StartEndToVideoNode",
 		Inputs: map[string]Value{
@@ -17591,12 +18178,13 @@ func Vidu2StartEndToVideoNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, mod
 			"movement_amplitude": String(movement_amplitude),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // Vidu2TextToVideoNode - Vidu2 Text-to-Video Generation
-func Vidu2TextToVideoNode(gr *Graph, model string, prompt string, duration, seed int, aspect_ratio, resolution string, background_music bool) (_ *Node, video VIDEO) {
+func Vidu2TextToVideoNode(gr *Graph, model string, prompt string, duration, seed int, aspect_ratio, resolution string, background_music bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "Vidu2TextToVideoNode",
 		Inputs: map[string]Value{
@@ -17609,12 +18197,13 @@ func Vidu2TextToVideoNode(gr *Graph, model string, prompt string, duration, seed
 			"background_music": Bool(background_music),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // ViduImageToVideoNode - Vidu Image To Video Generation
-func ViduImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string) (_ *Node, video VIDEO) {
+func ViduImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ViduImageToVideoNode",
 		Inputs: map[string]Value{
@@ -17622,12 +18211,13 @@ func ViduImageToVideoNode(gr *Graph, image IMAGE, model string, prompt string, d
 			"image": Link(image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // ViduReferenceVideoNode - Vidu Reference To Video Generation
-func ViduReferenceVideoNode(gr *Graph, images IMAGE, model string, prompt string, duration, seed int, aspect_ratio, resolution, movement_amplitude string) (_ *Node, video VIDEO) {
+func ViduReferenceVideoNode(gr *Graph, images IMAGE, model string, prompt string, duration, seed int, aspect_ratio, resolution, movement_amplitude string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ViduReferenceVideoNode",
 		Inputs: map[string]Value{
@@ -17636,12 +18226,13 @@ func ViduReferenceVideoNode(gr *Graph, images IMAGE, model string, prompt string
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // ViduStartEndToVideoNode - Vidu Start End To Video Generation
-func ViduStartEndToVideoNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string) (_ *Node, video VIDEO) {
+func ViduStartEndToVideoNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, model string, prompt string, duration, seed int, resolution, movement_amplitude string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ViduStartEndToVideoNode",
 		Inputs: map[string]Value{
@@ -17650,12 +18241,13 @@ func ViduStartEndToVideoNode(gr *Graph, first_frame IMAGE, end_frame IMAGE, mode
 			"end_frame":   Link(end_frame),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
 // ViduTextToVideoNode - Vidu Text To Video Generation
-func ViduTextToVideoNode(gr *Graph, model string, prompt string, duration, seed int, aspect_ratio, resolution, movement_amplitude string) (_ *Node, video VIDEO) {
+func ViduTextToVideoNode(gr *Graph, model string, prompt string, duration, seed int, aspect_ratio, resolution, movement_amplitude string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "ViduTextToVideoNode",
 		Inputs: map[string]Value{
@@ -17663,11 +18255,12 @@ func ViduTextToVideoNode(gr *Graph, model string, prompt string, duration, seed
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func VoxelToMesh(gr *Graph, voxel VOXEL, algorithm string, threshold float64) (_ *Node, mesh MESH) {
+func VoxelToMesh(gr *Graph, voxel VOXEL, algorithm string, threshold float64, opts ...NodeOption) (_ *Node, mesh MESH) {
 	nd := &Node{
 		Class: "VoxelToMesh",
 		Inputs: map[string]Value{
@@ -17676,11 +18269,12 @@ func VoxelToMesh(gr *Graph, voxel VOXEL, algorithm string, threshold float64) (_
 			"threshold": Float(threshold),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MESH{NodeID: id, OutPort: 0}
 }
 
-func VoxelToMeshBasic(gr *Graph, voxel VOXEL, threshold float64) (_ *Node, mesh MESH) {
+func VoxelToMeshBasic(gr *Graph, voxel VOXEL, threshold float64, opts ...NodeOption) (_ *Node, mesh MESH) {
 	nd := &Node{
 		Class: "VoxelToMeshBasic",
 		Inputs: map[string]Value{
@@ -17688,11 +18282,12 @@ func VoxelToMeshBasic(gr *Graph, voxel VOXEL, threshold float64) (_ *Node, mesh
 			"threshold": Float(threshold),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MESH{NodeID: id, OutPort: 0}
 }
 
-func Wan22FunControlToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, ref_image IMAGE, control_video IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func Wan22FunControlToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, ref_image IMAGE, control_video IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "Wan22FunControlToVideo",
 		Inputs: map[string]Value{
@@ -17705,11 +18300,12 @@ func Wan22FunControlToVideo(gr *Graph, positive CONDITIONING, negative CONDITION
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func Wan22ImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, width, height, length, batch_size int) (_ *Node, latent LATENT) {
+func Wan22ImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, latent LATENT) {
 	nd := &Node{
 		Class: "Wan22ImageToVideoLatent",
 		Inputs: map[string]Value{
@@ -17720,11 +18316,12 @@ func Wan22ImageToVideoLatent(gr *Graph, vae VAE, start_image IMAGE, width, heigh
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, LATENT{NodeID: id, OutPort: 0}
 }
 
-func WanAnimateToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, reference_image IMAGE, face_video IMAGE, pose_video IMAGE, background_video IMAGE, character_mask MASK, continue_motion IMAGE, width, height, length, batch_size, continue_motion_max_frames, video_frame_offset int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT, trim_latent INT, trim_image INT, out_video_frame_offset INT) {
+func WanAnimateToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, reference_image IMAGE, face_video IMAGE, pose_video IMAGE, background_video IMAGE, character_mask MASK, continue_motion IMAGE, width, height, length, batch_size, continue_motion_max_frames, video_frame_offset int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT, trim_latent INT, trim_image INT, out_video_frame_offset INT) {
 	nd := &Node{
 		Class: "WanAnimateToVideo",
 		Inputs: map[string]Value{
@@ -17739,11 +18336,12 @@ func WanAnimateToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING,
 			"video_frame_offset":         Int(video_frame_offset),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}, INT{NodeID: id, OutPort: 3}, INT{NodeID: id, OutPort: 4}, INT{NodeID: id, OutPort: 5}
 }
 
-func WanCameraEmbedding(gr *Graph, camera_pose string, width, height, length int, speed, fx, fy, cx, cy float64) (_ *Node, camera_embedding WAN_CAMERA_EMBEDDING, out_width INT, out_height INT, out_length INT) {
+func WanCameraEmbedding(gr *Graph, camera_pose string, width, height, length int, speed, fx, fy, cx, cy float64, opts ...NodeOption) (_ *Node, camera_embedding WAN_CAMERA_EMBEDDING, out_width INT, out_height INT, out_length INT) {
 	nd := &Node{
 		Class: "WanCameraEmbedding",
 		Inputs: map[string]Value{
@@ -17753,11 +18351,12 @@ func WanCameraEmbedding(gr *Graph, camera_pose string, width, height, length int
 			"length":      Int(length),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, WAN_CAMERA_EMBEDDING{NodeID: id, OutPort: 0}, INT{NodeID: id, OutPort: 1}, INT{NodeID: id, OutPort: 2}, INT{NodeID: id, OutPort: 3}
 }
 
-func WanCameraImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, camera_conditions WAN_CAMERA_EMBEDDING, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanCameraImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, camera_conditions WAN_CAMERA_EMBEDDING, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanCameraImageToVideo",
 		Inputs: map[string]Value{
@@ -17770,12 +18369,13 @@ func WanCameraImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONI
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // WanContextWindowsManual - WAN Context Windows (Manual)
-func WanContextWindowsManual(gr *Graph, model MODEL, context_length, context_overlap int, context_schedule string, context_stride int, closed_loop bool, fuse_method string, freenoise bool) (_ *Node, out_model MODEL) {
+func WanContextWindowsManual(gr *Graph, model MODEL, context_length, context_overlap int, context_schedule string, context_stride int, closed_loop bool, fuse_method string, freenoise bool, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "WanContextWindowsManual",
 		Inputs: map[string]Value{
@@ -17789,11 +18389,12 @@ func WanContextWindowsManual(gr *Graph, model MODEL, context_length, context_ove
 			"freenoise":        Bool(freenoise),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
-func WanFirstLastFrameToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_start_image CLIP_VISION_OUTPUT, clip_vision_end_image CLIP_VISION_OUTPUT, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanFirstLastFrameToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_start_image CLIP_VISION_OUTPUT, clip_vision_end_image CLIP_VISION_OUTPUT, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanFirstLastFrameToVideo",
 		Inputs: map[string]Value{
@@ -17806,11 +18407,12 @@ func WanFirstLastFrameToVideo(gr *Graph, positive CONDITIONING, negative CONDITI
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func WanFunControlToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, control_video IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanFunControlToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, control_video IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanFunControlToVideo",
 		Inputs: map[string]Value{
@@ -17823,11 +18425,12 @@ func WanFunControlToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONIN
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func WanFunInpaintToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanFunInpaintToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, end_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanFunInpaintToVideo",
 		Inputs: map[string]Value{
@@ -17840,11 +18443,12 @@ func WanFunInpaintToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONIN
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func WanHuMoImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, audio_encoder_output AUDIO_ENCODER_OUTPUT, ref_image IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanHuMoImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, audio_encoder_output AUDIO_ENCODER_OUTPUT, ref_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanHuMoImageToVideo",
 		Inputs: map[string]Value{
@@ -17857,12 +18461,13 @@ func WanHuMoImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // WanImageToImageApi - Wan Image to Image
-func WanImageToImageApi(gr *Graph, image IMAGE, model string, prompt, negative_prompt string, seed int, watermark bool) (_ *Node, out_image IMAGE) {
+func WanImageToImageApi(gr *Graph, image IMAGE, model string, prompt, negative_prompt string, seed int, watermark bool, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "WanImageToImageApi",
 		Inputs: map[string]Value{
@@ -17871,11 +18476,12 @@ func WanImageToImageApi(gr *Graph, image IMAGE, model string, prompt, negative_p
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func WanImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, clip_vision_output CLIP_VISION_OUTPUT, start_image IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanImageToVideo",
 		Inputs: map[string]Value{
@@ -17888,12 +18494,13 @@ func WanImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, va
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // WanImageToVideoApi - Wan Image to Video
-func WanImageToVideoApi(gr *Graph, image IMAGE, audio AUDIO, model string, prompt, negative_prompt string, resolution string, duration int, seed int, generate_audio, prompt_extend, watermark bool, shot_type string) (_ *Node, video VIDEO) {
+func WanImageToVideoApi(gr *Graph, image IMAGE, audio AUDIO, model string, prompt, negative_prompt string, resolution string, duration int, seed int, generate_audio, prompt_extend, watermark bool, shot_type string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "WanImageToVideoApi",
 		Inputs: map[string]Value{
@@ -17902,22 +18509,24 @@ func WanImageToVideoApi(gr *Graph, image IMAGE, audio AUDIO, model string, promp
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func WanMoveConcatTrack(gr *Graph, tracks_1 TRACKS, tracks_2 TRACKS) (_ *Node, tracks TRACKS) {
+func WanMoveConcatTrack(gr *Graph, tracks_1 TRACKS, tracks_2 TRACKS, opts ...NodeOption) (_ *Node, tracks TRACKS) {
 	nd := &Node{
 		Class: "WanMoveConcatTrack",
 		Inputs: map[string]Value{
 			"tracks_1": Link(tracks_1),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, TRACKS{NodeID: id, OutPort: 0}
 }
 
-func WanMoveTrackToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, tracks TRACKS, clip_vision_output CLIP_VISION_OUTPUT, strength float64, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanMoveTrackToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, tracks TRACKS, clip_vision_output CLIP_VISION_OUTPUT, strength float64, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanMoveTrackToVideo",
 		Inputs: map[string]Value{
@@ -17932,20 +18541,22 @@ func WanMoveTrackToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING
 			"start_image": Link(start_image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func WanMoveTracksFromCoords(gr *Graph, track_mask MASK, track_coords string) (_ *Node, tracks TRACKS, track_length INT) {
+func WanMoveTracksFromCoords(gr *Graph, track_mask MASK, track_coords string, opts ...NodeOption) (_ *Node, tracks TRACKS, track_length INT) {
 	nd := &Node{
 		Class:  "WanMoveTracksFromCoords",
 		Inputs: map[string]Value{},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, TRACKS{NodeID: id, OutPort: 0}, INT{NodeID: id, OutPort: 1}
 }
 
-func WanMoveVisualizeTracks(gr *Graph, images IMAGE, tracks TRACKS, line_resolution, circle_size int, opacity float64, line_width int) (_ *Node, image IMAGE) {
+func WanMoveVisualizeTracks(gr *Graph, images IMAGE, tracks TRACKS, line_resolution, circle_size int, opacity float64, line_width int, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "WanMoveVisualizeTracks",
 		Inputs: map[string]Value{
@@ -17956,11 +18567,12 @@ func WanMoveVisualizeTracks(gr *Graph, images IMAGE, tracks TRACKS, line_resolut
 			"line_width":      Int(line_width),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func WanPhantomSubjectToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, images IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, negative_text CONDITIONING, negative_img_text CONDITIONING, latent LATENT) {
+func WanPhantomSubjectToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, images IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, negative_text CONDITIONING, negative_img_text CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanPhantomSubjectToVideo",
 		Inputs: map[string]Value{
@@ -17973,12 +18585,13 @@ func WanPhantomSubjectToVideo(gr *Graph, positive CONDITIONING, negative CONDITI
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, CONDITIONING{NodeID: id, OutPort: 2}, LATENT{NodeID: id, OutPort: 3}
 }
 
 // WanReferenceVideoApi - Wan Reference to Video
-func WanReferenceVideoApi(gr *Graph, reference_videos COMFY_AUTOGROW_V3, model string, prompt, negative_prompt string, size string, duration, seed int, shot_type string, watermark bool) (_ *Node, video VIDEO) {
+func WanReferenceVideoApi(gr *Graph, reference_videos COMFY_AUTOGROW_V3, model string, prompt, negative_prompt string, size string, duration, seed int, shot_type string, watermark bool, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "WanReferenceVideoApi",
 		Inputs: map[string]Value{
@@ -17993,11 +18606,12 @@ func WanReferenceVideoApi(gr *Graph, reference_videos COMFY_AUTOGROW_V3, model s
 			"watermark":        Bool(watermark),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func WanSoundImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, audio_encoder_output AUDIO_ENCODER_OUTPUT, ref_image IMAGE, control_video IMAGE, ref_motion IMAGE, width, height, length, batch_size int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanSoundImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, audio_encoder_output AUDIO_ENCODER_OUTPUT, ref_image IMAGE, control_video IMAGE, ref_motion IMAGE, width, height, length, batch_size int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanSoundImageToVideo",
 		Inputs: map[string]Value{
@@ -18010,11 +18624,12 @@ func WanSoundImageToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONIN
 			"batch_size": Int(batch_size),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func WanSoundImageToVideoExtend(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, video_latent LATENT, audio_encoder_output AUDIO_ENCODER_OUTPUT, ref_image IMAGE, control_video IMAGE, length int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanSoundImageToVideoExtend(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, video_latent LATENT, audio_encoder_output AUDIO_ENCODER_OUTPUT, ref_image IMAGE, control_video IMAGE, length int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanSoundImageToVideoExtend",
 		Inputs: map[string]Value{
@@ -18025,12 +18640,13 @@ func WanSoundImageToVideoExtend(gr *Graph, positive CONDITIONING, negative CONDI
 			"video_latent": Link(video_latent),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
 // WanTextToImageApi - Wan Text to Image
-func WanTextToImageApi(gr *Graph, model string, prompt, negative_prompt string, width, height, seed int, prompt_extend, watermark bool) (_ *Node, image IMAGE) {
+func WanTextToImageApi(gr *Graph, model string, prompt, negative_prompt string, width, height, seed int, prompt_extend, watermark bool, opts ...NodeOption) (_ *Node, image IMAGE) {
 	nd := &Node{
 		Class: "WanTextToImageApi",
 		Inputs: map[string]Value{
@@ -18038,12 +18654,13 @@ func WanTextToImageApi(gr *Graph, model string, prompt, negative_prompt string,
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
 // WanTextToVideoApi - Wan Text to Video
-func WanTextToVideoApi(gr *Graph, audio AUDIO, model string, prompt, negative_prompt string, size string, duration int, seed int, generate_audio, prompt_extend, watermark bool, shot_type string) (_ *Node, video VIDEO) {
+func WanTextToVideoApi(gr *Graph, audio AUDIO, model string, prompt, negative_prompt string, size string, duration int, seed int, generate_audio, prompt_extend, watermark bool, shot_type string, opts ...NodeOption) (_ *Node, video VIDEO) {
 	nd := &Node{
 		Class: "WanTextToVideoApi",
 		Inputs: map[string]Value{
@@ -18051,11 +18668,12 @@ func WanTextToVideoApi(gr *Graph, audio AUDIO, model string, prompt, negative_pr
 			"prompt": String(prompt),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, VIDEO{NodeID: id, OutPort: 0}
 }
 
-func WanTrackToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, clip_vision_output CLIP_VISION_OUTPUT, tracks string, width, height, length, batch_size int, temperature float64, topk int) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
+func WanTrackToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, start_image IMAGE, clip_vision_output CLIP_VISION_OUTPUT, tracks string, width, height, length, batch_size int, temperature float64, topk int, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT) {
 	nd := &Node{
 		Class: "WanTrackToVideo",
 		Inputs: map[string]Value{
@@ -18072,11 +18690,12 @@ func WanTrackToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, va
 			"start_image": Link(start_image),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}
 }
 
-func WanVaceToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, control_video IMAGE, control_masks MASK, reference_image IMAGE, width, height, length, batch_size int, strength float64) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT, trim_latent INT) {
+func WanVaceToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae VAE, control_video IMAGE, control_masks MASK, reference_image IMAGE, width, height, length, batch_size int, strength float64, opts ...NodeOption) (_ *Node, out_positive CONDITIONING, out_negative CONDITIONING, latent LATENT, trim_latent INT) {
 	nd := &Node{
 		Class: "WanVaceToVideo",
 		Inputs: map[string]Value{
@@ -18090,12 +18709,13 @@ func WanVaceToVideo(gr *Graph, positive CONDITIONING, negative CONDITIONING, vae
 			"strength":   Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}, CONDITIONING{NodeID: id, OutPort: 1}, LATENT{NodeID: id, OutPort: 2}, INT{NodeID: id, OutPort: 3}
 }
 
 // WebcamCapture - Webcam Capture
-func WebcamCapture(gr *Graph, image WEBCAM, width, height int, capture_on_queue bool) (_ *Node, out_image IMAGE) {
+func WebcamCapture(gr *Graph, image WEBCAM, width, height int, capture_on_queue bool, opts ...NodeOption) (_ *Node, out_image IMAGE) {
 	nd := &Node{
 		Class: "WebcamCapture",
 		Inputs: map[string]Value{
@@ -18105,11 +18725,12 @@ func WebcamCapture(gr *Graph, image WEBCAM, width, height int, capture_on_queue
 			"capture_on_queue": Bool(capture_on_queue),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, IMAGE{NodeID: id, OutPort: 0}
 }
 
-func ZImageFunControlnet(gr *Graph, model MODEL, model_patch MODEL_PATCH, vae VAE, image IMAGE, inpaint_image IMAGE, mask MASK, strength float64) (_ *Node, out_model MODEL) {
+func ZImageFunControlnet(gr *Graph, model MODEL, model_patch MODEL_PATCH, vae VAE, image IMAGE, inpaint_image IMAGE, mask MASK, strength float64, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "ZImageFunControlnet",
 		Inputs: map[string]Value{
@@ -18119,24 +18740,26 @@ func ZImageFunControlnet(gr *Graph, model MODEL, model_patch MODEL_PATCH, vae VA
 			"strength":    Float(strength),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
 
 // UnCLIPCheckpointLoader - unCLIPCheckpointLoader
-func UnCLIPCheckpointLoader(gr *Graph, ckpt_name string) (_ *Node, model MODEL, clip CLIP, vae VAE, clip_vision CLIP_VISION) {
+func UnCLIPCheckpointLoader(gr *Graph, ckpt_name string, opts ...NodeOption) (_ *Node, model MODEL, clip CLIP, vae VAE, clip_vision CLIP_VISION) {
 	nd := &Node{
 		Class: "unCLIPCheckpointLoader",
 		Inputs: map[string]Value{
 			"ckpt_name": String(ckpt_name),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}, CLIP{NodeID: id, OutPort: 1}, VAE{NodeID: id, OutPort: 2}, CLIP_VISION{NodeID: id, OutPort: 3}
 }
 
 // UnCLIPConditioning - unCLIPConditioning
-func UnCLIPConditioning(gr *Graph, conditioning CONDITIONING, clip_vision_output CLIP_VISION_OUTPUT, strength, noise_augmentation float64) (_ *Node, out_conditioning CONDITIONING) {
+func UnCLIPConditioning(gr *Graph, conditioning CONDITIONING, clip_vision_output CLIP_VISION_OUTPUT, strength, noise_augmentation float64, opts ...NodeOption) (_ *Node, out_conditioning CONDITIONING) {
 	nd := &Node{
 		Class: "unCLIPConditioning",
 		Inputs: map[string]Value{
@@ -18146,17 +18769,19 @@ func UnCLIPConditioning(gr *Graph, conditioning CONDITIONING, clip_vision_output
 			"noise_augmentation": Float(noise_augmentation),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, CONDITIONING{NodeID: id, OutPort: 0}
 }
 
-func WanBlockSwap(gr *Graph, model MODEL) (_ *Node, out_model MODEL) {
+func WanBlockSwap(gr *Graph, model MODEL, opts ...NodeOption) (_ *Node, out_model MODEL) {
 	nd := &Node{
 		Class: "wanBlockSwap",
 		Inputs: map[string]Value{
 			"model": Link(model),
 		},
 	}
+	applyNodeOptions(nd, opts)
 	id := gr.Add(nd)
 	return nd, MODEL{NodeID: id, OutPort: 0}
 }
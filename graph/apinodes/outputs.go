@@ -0,0 +1,102 @@
+package apinodes
+
+import (
+	"context"
+	"image"
+	"io"
+
+	"github.com/dennwc/gocomfy/graph/types"
+	ctypes "github.com/dennwc/gocomfy/types"
+)
+
+// Fetcher downloads output files. It is implemented by gocomfy.Client.
+type Fetcher interface {
+	GetImageFile(ctx context.Context, ref ctypes.ImageRef) (io.ReadCloser, string, error)
+	GetImage(ctx context.Context, ref ctypes.ImageRef) (image.Image, error)
+}
+
+// Output is a handle for results of an output node.
+type Output struct {
+	NodeID types.NodeID
+}
+
+// From returns results of the node.
+func (o Output) From(res ctypes.Results) ctypes.NodeResult {
+	return res[o.NodeID]
+}
+
+// Fetch downloads all output files of the node.
+func (o Output) Fetch(ctx context.Context, c Fetcher, res ctypes.Results) ([][]byte, error) {
+	return fetchFiles(ctx, c, res[o.NodeID].Files())
+}
+
+// ImageOutput is a handle for results of a node that outputs images.
+type ImageOutput struct {
+	Output
+}
+
+// From returns references to output images.
+func (o ImageOutput) From(res ctypes.Results) []ctypes.ImageRef {
+	return res[o.NodeID].Images
+}
+
+// Fetch downloads and decodes output images.
+func (o ImageOutput) Fetch(ctx context.Context, c Fetcher, res ctypes.Results) ([]image.Image, error) {
+	refs := o.From(res)
+	out := make([]image.Image, 0, len(refs))
+	for _, ref := range refs {
+		img, err := c.GetImage(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, img)
+	}
+	return out, nil
+}
+
+// VideoOutput is a handle for results of a node that outputs videos.
+type VideoOutput struct {
+	Output
+}
+
+// From returns references to output videos.
+func (o VideoOutput) From(res ctypes.Results) []ctypes.ImageRef {
+	return res[o.NodeID].Images
+}
+
+// Fetch downloads output videos.
+func (o VideoOutput) Fetch(ctx context.Context, c Fetcher, res ctypes.Results) ([][]byte, error) {
+	return fetchFiles(ctx, c, o.From(res))
+}
+
+// AudioOutput is a handle for results of a node that outputs audio.
+type AudioOutput struct {
+	Output
+}
+
+// From returns references to output audio files.
+func (o AudioOutput) From(res ctypes.Results) []ctypes.ImageRef {
+	return res[o.NodeID].Audio
+}
+
+// Fetch downloads output audio files.
+func (o AudioOutput) Fetch(ctx context.Context, c Fetcher, res ctypes.Results) ([][]byte, error) {
+	return fetchFiles(ctx, c, o.From(res))
+}
+
+func fetchFiles(ctx context.Context, c Fetcher, refs []ctypes.ImageRef) ([][]byte, error) {
+	out := make([][]byte, 0, len(refs))
+	for _, ref := range refs {
+		rc, _, err := c.GetImageFile(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
@@ -16,3 +16,27 @@ type Int = apigraph.Int
 type Float = apigraph.Float
 type String = apigraph.String
 type Bool = apigraph.Bool
+
+// NodeOption is an optional setting of nodes created by constructors in this package.
+type NodeOption interface {
+	applyToNode(n *Node)
+}
+
+type nodeOptionFunc func(n *Node)
+
+func (f nodeOptionFunc) applyToNode(n *Node) {
+	f(n)
+}
+
+// Title sets the title of the node, as displayed in ComfyUI.
+func Title(title string) NodeOption {
+	return nodeOptionFunc(func(n *Node) {
+		n.SetTitle(title)
+	})
+}
+
+func applyNodeOptions(n *Node, opts []NodeOption) {
+	for _, opt := range opts {
+		opt.applyToNode(n)
+	}
+}
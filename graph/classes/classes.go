@@ -263,7 +263,7 @@ func convertClasses(res map[types.NodeClass]jsonClass) (map[types.NodeClass]*Cla
 			Category: jobj.Category,
 			Inputs:   make([]Input, 0, len(jobj.Input.Required)+len(jobj.Input.Optional)+len(jobj.Input.Hidden)),
 			Outputs:  make([]Output, 0, len(jobj.OutTypes)),
-			IsOutput: false,
+			IsOutput: jobj.IsOutput,
 		}
 		out[key] = obj
 		for _, kv := range jobj.Input.Required {
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 )
 
 type NodeID uint
@@ -19,6 +21,84 @@ func (id *NodeID) Parse(s string) error {
 	return nil
 }
 
+// NodePath is a hierarchical node ID, as reported by ComfyUI for nodes inside subgraphs (e.g. "12:5").
+//
+// The first element is the ID of the node in the top-level graph, and each next element is the ID
+// of the node inside the subgraph of the previous one. Top-level nodes have a single element.
+type NodePath string
+
+// PathSep is a separator between elements of the node path.
+const PathSep = ":"
+
+// PathOf constructs a node path from node IDs.
+func PathOf(ids ...NodeID) NodePath {
+	var sb strings.Builder
+	for i, id := range ids {
+		if i != 0 {
+			sb.WriteString(PathSep)
+		}
+		sb.WriteString(id.String())
+	}
+	return NodePath(sb.String())
+}
+
+func (p NodePath) String() string {
+	return string(p)
+}
+
+// IsNested checks if the path refers to a node inside a subgraph.
+func (p NodePath) IsNested() bool {
+	return strings.Contains(string(p), PathSep)
+}
+
+// Parent returns a path of the parent subgraph node, or an empty path for top-level nodes.
+func (p NodePath) Parent() NodePath {
+	i := strings.LastIndex(string(p), PathSep)
+	if i < 0 {
+		return ""
+	}
+	return p[:i]
+}
+
+// Root returns the ID of the top-level node which contains this node. For top-level nodes it returns the node ID itself.
+//
+// This is the node that is displayed in the UI for nodes inside subgraphs.
+func (p NodePath) Root() (NodeID, error) {
+	s, _, _ := strings.Cut(string(p), PathSep)
+	id, err := parsePathElem(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid node path %q: %w", string(p), err)
+	}
+	return id, nil
+}
+
+// IDs returns IDs of all nodes on the path, starting from the top-level node.
+// Node expansion suffixes are stripped from each element, the same way as in Root.
+func (p NodePath) IDs() ([]NodeID, error) {
+	if p == "" {
+		return nil, nil
+	}
+	parts := strings.Split(string(p), PathSep)
+	ids := make([]NodeID, 0, len(parts))
+	for _, s := range parts {
+		id, err := parsePathElem(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node path %q: %w", string(p), err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parsePathElem parses a single element of the node path.
+func parsePathElem(s string) (NodeID, error) {
+	// Nodes created by node expansion have IDs like "5.0.0.1", where the first element is the ID of the expanded node.
+	s, _, _ = strings.Cut(s, ".")
+	var id NodeID
+	err := id.Parse(s)
+	return id, err
+}
+
 type NodeClass string
 
 type TypeName string
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestNodePath(t *testing.T) {
+	for _, c := range []struct {
+		path NodePath
+		root NodeID
+		ids  []NodeID
+	}{
+		{path: "5", root: 5, ids: []NodeID{5}},
+		{path: "12:5", root: 12, ids: []NodeID{12, 5}},
+		{path: "5.0.0.1", root: 5, ids: []NodeID{5}},
+		{path: "12:5.0.1:3", root: 12, ids: []NodeID{12, 5, 3}},
+	} {
+		t.Run(string(c.path), func(t *testing.T) {
+			root, err := c.path.Root()
+			must.NoError(t, err)
+			must.EqOp(t, c.root, root)
+			ids, err := c.path.IDs()
+			must.NoError(t, err)
+			must.Eq(t, c.ids, ids)
+			must.EqOp(t, ids[0], root)
+		})
+	}
+	_, err := NodePath("12:x").IDs()
+	must.Error(t, err)
+}
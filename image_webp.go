@@ -0,0 +1,166 @@
+package gocomfy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+	"time"
+
+	"golang.org/x/image/riff"
+	"golang.org/x/image/webp"
+)
+
+var (
+	fccWEBP = riff.FourCC{'W', 'E', 'B', 'P'}
+	fccVP8X = riff.FourCC{'V', 'P', '8', 'X'}
+	fccANMF = riff.FourCC{'A', 'N', 'M', 'F'}
+	fccALPH = riff.FourCC{'A', 'L', 'P', 'H'}
+)
+
+// decodeWebPFrames decodes all frames of a WebP image.
+//
+// Still images are returned as a single frame. Frames of animated images are composited on the canvas,
+// thus each frame is a full image, similar to what a viewer would display.
+func decodeWebPFrames(data []byte) ([]Frame, error) {
+	typ, rr, err := riff.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if typ != fccWEBP {
+		return nil, errors.New("webp: invalid format")
+	}
+	var (
+		canvas *image.NRGBA
+		frames []Frame
+	)
+	for {
+		id, _, chunk, err := rr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		switch id {
+		case fccVP8X:
+			var buf [10]byte
+			if _, err := io.ReadFull(chunk, buf[:]); err != nil {
+				return nil, err
+			}
+			const animationBit = 1 << 1
+			if buf[0]&animationBit == 0 {
+				// still image with extended header
+				img, err := webp.Decode(bytes.NewReader(data))
+				if err != nil {
+					return nil, err
+				}
+				return []Frame{{Image: img}}, nil
+			}
+			w, h := uint24(buf[4:7])+1, uint24(buf[7:10])+1
+			canvas = image.NewNRGBA(image.Rect(0, 0, int(w), int(h)))
+		case fccANMF:
+			if canvas == nil {
+				return nil, errors.New("webp: animation frame without a canvas")
+			}
+			payload, err := io.ReadAll(chunk)
+			if err != nil {
+				return nil, err
+			}
+			f, err := decodeWebPFrame(canvas, payload)
+			if err != nil {
+				return nil, err
+			}
+			frames = append(frames, f)
+		default:
+			if canvas == nil {
+				// simple lossy or lossless format
+				img, err := webp.Decode(bytes.NewReader(data))
+				if err != nil {
+					return nil, err
+				}
+				return []Frame{{Image: img}}, nil
+			}
+		}
+	}
+	if len(frames) == 0 {
+		return nil, errors.New("webp: no frames")
+	}
+	return frames, nil
+}
+
+// decodeWebPFrame decodes a single ANMF chunk and renders it on the canvas.
+func decodeWebPFrame(canvas *image.NRGBA, payload []byte) (Frame, error) {
+	const hdrSize = 16
+	if len(payload) < hdrSize {
+		return Frame{}, errors.New("webp: invalid animation frame")
+	}
+	var (
+		x     = int(uint24(payload[0:3])) * 2
+		y     = int(uint24(payload[3:6])) * 2
+		w     = uint24(payload[6:9]) + 1
+		h     = uint24(payload[9:12]) + 1
+		dur   = uint24(payload[12:15])
+		flags = payload[15]
+	)
+	const (
+		disposeBit = 1 << 0
+		noBlendBit = 1 << 1
+	)
+	// Frame data is a sequence of ALPH and VP8/VP8L chunks.
+	// Wrap them into a separate WebP file, so it can be decoded as a still image.
+	body := payload[hdrSize:]
+	hasAlpha := false
+	for p := body; len(p) >= 8; {
+		sz := binary.LittleEndian.Uint32(p[4:8])
+		if riff.FourCC(p[0:4]) == fccALPH {
+			hasAlpha = true
+		}
+		sz += sz & 1
+		if uint32(len(p)-8) < sz {
+			break
+		}
+		p = p[8+sz:]
+	}
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	buf.Write([]byte{0, 0, 0, 0})
+	buf.WriteString("WEBP")
+	if hasAlpha {
+		const alphaBit = 1 << 4
+		buf.WriteString("VP8X")
+		buf.Write([]byte{10, 0, 0, 0})
+		buf.Write([]byte{alphaBit, 0, 0, 0})
+		buf.Write(putUint24(w - 1))
+		buf.Write(putUint24(h - 1))
+	}
+	buf.Write(body)
+	data := buf.Bytes()
+	binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-8))
+
+	img, err := webp.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Frame{}, err
+	}
+	rect := image.Rect(x, y, x+int(w), y+int(h)).Intersect(canvas.Bounds())
+	op := draw.Over
+	if flags&noBlendBit != 0 {
+		op = draw.Src
+	}
+	draw.Draw(canvas, rect, img, img.Bounds().Min, op)
+	out := image.NewNRGBA(canvas.Bounds())
+	copy(out.Pix, canvas.Pix)
+	if flags&disposeBit != 0 {
+		draw.Draw(canvas, rect, image.Transparent, image.Point{}, draw.Src)
+	}
+	return Frame{Image: out, Delay: time.Duration(dur) * time.Millisecond}, nil
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
+
+func putUint24(v uint32) []byte {
+	return []byte{byte(v), byte(v >> 8), byte(v >> 16)}
+}
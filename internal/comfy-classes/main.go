@@ -392,6 +392,13 @@ func generateGraphNodes(buf *bytes.Buffer, c *classes.Class) {
 			panic("unsupported scalar: " + string(p.Type))
 		}
 	}
+	optsName := "opts"
+	if _, ok := argNames[optsName]; ok {
+		optsName = "nodeOpts"
+	}
+	buf.WriteString(", ")
+	buf.WriteString(optsName)
+	buf.WriteString(" ...NodeOption")
 	buf.WriteString(") ")
 	// returns
 	buf.WriteString("(_ *Node")
@@ -414,6 +421,11 @@ func generateGraphNodes(buf *bytes.Buffer, c *classes.Class) {
 			buf.WriteString(classes.GoLinkType(string(p.Type)))
 		}
 	}
+	handle := outputHandle(c)
+	if handle != "" {
+		buf.WriteString(", _ ")
+		buf.WriteString(handle)
+	}
 	buf.WriteString(") ")
 	// body
 	buf.WriteString("{\n")
@@ -448,7 +460,8 @@ func generateGraphNodes(buf *bytes.Buffer, c *classes.Class) {
 		buf.WriteString(",\n")
 	}
 	buf.WriteString("\t\t},\n\t}\n")
-	if len(c.Outputs) == 0 {
+	fmt.Fprintf(buf, "\tapplyNodeOptions(nd, %s)\n", optsName)
+	if len(c.Outputs) == 0 && handle == "" {
 		buf.WriteString("\tgr.Add(nd)\n")
 		buf.WriteString("\treturn nd")
 		return
@@ -465,5 +478,39 @@ func generateGraphNodes(buf *bytes.Buffer, c *classes.Class) {
 		}
 		fmt.Fprintf(buf, "{NodeID: id, OutPort: %d}", i)
 	}
+	switch handle {
+	case "":
+	case "Output":
+		buf.WriteString(", Output{NodeID: id}")
+	default:
+		fmt.Fprintf(buf, ", %s{Output{NodeID: id}}", handle)
+	}
 	buf.WriteString("\n")
 }
+
+// outputHandle returns the name of the typed output handle returned by the output node constructor.
+func outputHandle(c *classes.Class) string {
+	if !c.IsOutput {
+		return ""
+	}
+	name := strings.ToLower(string(c.Name))
+	if strings.Contains(name, "video") || strings.Contains(name, "webm") {
+		return "VideoOutput"
+	}
+	if len(c.Outputs) != 0 {
+		// nodes like API model generators take images as inputs, but output other files
+		return "Output"
+	}
+	for _, p := range c.Inputs {
+		if p.Kind != classes.InputRequired {
+			continue
+		}
+		switch p.Type {
+		case "AUDIO":
+			return "AudioOutput"
+		case "IMAGE", "MASK":
+			return "ImageOutput"
+		}
+	}
+	return "Output"
+}
@@ -4,6 +4,7 @@ import "log/slog"
 
 type CommonOption interface {
 	ClientOption
+	PoolOption
 }
 
 func WithLog(log *slog.Logger) CommonOption {
@@ -15,3 +16,7 @@ type withLog slog.Logger
 func (opt *withLog) applyToClient(c *clientOptions) {
 	c.Log = (*slog.Logger)(opt)
 }
+
+func (opt *withLog) applyToPool(c *poolOptions) {
+	c.Log = (*slog.Logger)(opt)
+}
@@ -0,0 +1,387 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+	"github.com/dennwc/gocomfy/graph/classes"
+	"github.com/dennwc/gocomfy/graph/types"
+)
+
+const (
+	defaultPoolRetries  = 3
+	defaultPoolCooldown = 30 * time.Second
+	poolClassesRefresh  = 30 * time.Second
+	poolValuesRefresh   = 5 * time.Second
+)
+
+type poolOptions struct {
+	Log      *slog.Logger
+	Retries  int
+	Cooldown time.Duration
+}
+
+type PoolOption interface {
+	applyToPool(o *poolOptions)
+}
+
+type poolOptionFunc func(o *poolOptions)
+
+func (f poolOptionFunc) applyToPool(o *poolOptions) {
+	f(o)
+}
+
+// WithPoolRetries sets the max number of backends tried for a single prompt.
+func WithPoolRetries(n int) PoolOption {
+	return poolOptionFunc(func(o *poolOptions) {
+		o.Retries = n
+	})
+}
+
+// WithPoolCooldown sets the time after which a backend that failed a request is tried again.
+//
+// Backends with a dropped websocket connection are never used again.
+func WithPoolCooldown(d time.Duration) PoolOption {
+	return poolOptionFunc(func(o *poolOptions) {
+		o.Cooldown = d
+	})
+}
+
+// ErrNoBackend is returned by the Pool if no backend can run the prompt.
+var ErrNoBackend = errors.New("no backend available")
+
+// Pool balances prompts across multiple ComfyUI servers.
+//
+// A backend is picked by the queue length reported by the server and the number of prompts submitted by the pool.
+// Backends that don't have node classes or models (combo input values) required by the prompt are skipped.
+// Combo inputs that accept uploaded files are not checked.
+// Node classes of each backend are cached, so new models may only be used a few seconds after they are added.
+// If the backend fails to accept the prompt, the next one is tried.
+type Pool struct {
+	log      *slog.Logger
+	retries  int
+	cooldown time.Duration
+	backends []*poolBackend
+	next     atomic.Uint32
+
+	// classesRefresh and valuesRefresh are min intervals between node class refreshes
+	// for missing classes and for unknown combo values respectively.
+	classesRefresh time.Duration
+	valuesRefresh  time.Duration
+}
+
+type poolBackend struct {
+	c        *Client
+	inflight atomic.Int64
+
+	// fetchMu serializes node class requests, so that the backend lock is not held while they are fetched.
+	fetchMu sync.Mutex
+
+	mu        sync.Mutex
+	failedAt  time.Time
+	classes   classes.Classes
+	classesAt time.Time
+}
+
+// NewPool creates a pool for given clients. The pool takes ownership of the clients.
+func NewPool(clients []*Client, opts ...PoolOption) *Pool {
+	opt := poolOptions{
+		Retries:  defaultPoolRetries,
+		Cooldown: defaultPoolCooldown,
+	}
+	for _, o := range opts {
+		o.applyToPool(&opt)
+	}
+	if opt.Log == nil {
+		opt.Log = slog.Default()
+	}
+	p := &Pool{
+		log:            opt.Log,
+		retries:        max(opt.Retries, 1),
+		cooldown:       opt.Cooldown,
+		classesRefresh: poolClassesRefresh,
+		valuesRefresh:  poolValuesRefresh,
+	}
+	for _, c := range clients {
+		p.backends = append(p.backends, &poolBackend{c: c})
+	}
+	return p
+}
+
+// Clients returns all clients in the pool.
+func (p *Pool) Clients() []*Client {
+	out := make([]*Client, 0, len(p.backends))
+	for _, b := range p.backends {
+		out = append(out, b.c)
+	}
+	return out
+}
+
+// Close closes all clients in the pool.
+func (p *Pool) Close() {
+	for _, b := range p.backends {
+		b.c.Close()
+	}
+}
+
+func (b *poolBackend) healthy(cooldown time.Duration) bool {
+	if !b.c.Alive() {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failedAt.IsZero() || time.Since(b.failedAt) >= cooldown
+}
+
+func (b *poolBackend) fail() {
+	b.mu.Lock()
+	b.failedAt = time.Now()
+	b.mu.Unlock()
+}
+
+func (b *poolBackend) load() int64 {
+	return max(int64(b.c.QueueSize()), b.inflight.Load())
+}
+
+func (b *poolBackend) cachedClasses() (classes.Classes, time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.classes, b.classesAt
+}
+
+// refreshClasses fetches node classes from the server, unless they were already refreshed after a given time.
+func (b *poolBackend) refreshClasses(ctx context.Context, since time.Time) (classes.Classes, error) {
+	b.fetchMu.Lock()
+	defer b.fetchMu.Unlock()
+	if all, at := b.cachedClasses(); all != nil && at.After(since) {
+		return all, nil // refreshed by a concurrent call
+	}
+	all, err := b.c.ObjectsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	b.classes, b.classesAt = all, time.Now()
+	b.mu.Unlock()
+	return all, nil
+}
+
+// supports checks if the backend has all node classes and models required by the prompt.
+func (p *Pool) supports(ctx context.Context, b *poolBackend, nodes map[string]promptNode) error {
+	all, at := b.cachedClasses()
+	if all != nil {
+		err := checkClasses(all, nodes)
+		if err == nil {
+			return nil
+		}
+		// Models, custom nodes and input files can be added at any time, so refresh the info before giving up.
+		// Models are added more often than node classes, thus unknown combo values are refreshed sooner.
+		refresh := p.classesRefresh
+		if errors.Is(err, errUnknownValue) {
+			refresh = p.valuesRefresh
+		}
+		if time.Since(at) < refresh {
+			return err
+		}
+	}
+	all, err := b.refreshClasses(ctx, at)
+	if err != nil {
+		return err
+	}
+	return checkClasses(all, nodes)
+}
+
+type promptNode struct {
+	Class  types.NodeClass            `json:"class_type"`
+	Inputs map[string]json.RawMessage `json:"inputs"`
+}
+
+func decodePromptNodes(prompt any) (map[string]promptNode, error) {
+	data, ok := prompt.(json.RawMessage)
+	if !ok {
+		var err error
+		data, err = json.Marshal(prompt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var nodes map[string]promptNode
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+var (
+	errNotAvailable = errors.New("not available")
+	errUnknownValue = errors.New("unknown value")
+)
+
+// uploadConfigs are input config flags of combo inputs that accept uploaded files.
+var uploadConfigs = []string{"image_upload", "video_upload", "audio_upload"}
+
+// isUploadInput checks if the combo input accepts uploaded files.
+// Its options may be outdated even after a refresh, since files can be uploaded right before the prompt is queued.
+func isUploadInput(in *classes.Input) bool {
+	var cfg map[string]json.RawMessage
+	if err := json.Unmarshal(in.Config, &cfg); err != nil {
+		return false
+	}
+	for _, name := range uploadConfigs {
+		if string(cfg[name]) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func checkClasses(all classes.Classes, nodes map[string]promptNode) error {
+	for id, n := range nodes {
+		cl := all[n.Class]
+		if cl == nil {
+			return fmt.Errorf("node %s: class %q: %w", id, n.Class, errNotAvailable)
+		}
+		for _, in := range cl.Inputs {
+			if !in.IsSelect || len(in.Select) == 0 || isUploadInput(&in) {
+				continue
+			}
+			var val string
+			if err := json.Unmarshal(n.Inputs[in.Name], &val); err != nil {
+				continue // link or a non-string value
+			}
+			if !slices.ContainsFunc(in.Select, func(o classes.Option) bool { return o.Name == val }) {
+				return fmt.Errorf("node %s: %s %q: %w: %w", id, in.Name, val, errUnknownValue, errNotAvailable)
+			}
+		}
+	}
+	return nil
+}
+
+// pick selects the least loaded healthy backend that supports the prompt.
+func (p *Pool) pick(ctx context.Context, nodes map[string]promptNode, skip map[*poolBackend]struct{}) (*poolBackend, error) {
+	// Start from a different backend each time to spread prompts across equally loaded backends.
+	off := int(p.next.Add(1))
+	var (
+		best    *poolBackend
+		lastErr error
+	)
+	for i := range p.backends {
+		b := p.backends[(off+i)%len(p.backends)]
+		if _, ok := skip[b]; ok || !b.healthy(p.cooldown) {
+			continue
+		}
+		if best != nil && b.load() >= best.load() {
+			continue
+		}
+		if err := p.supports(ctx, b, nodes); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			var serr *StatusError
+			if !errors.As(err, &serr) && !errors.Is(err, errNotAvailable) {
+				b.fail()
+			}
+			lastErr = err
+			continue
+		}
+		best = b
+	}
+	if best == nil {
+		if lastErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrNoBackend, lastErr)
+		}
+		return nil, ErrNoBackend
+	}
+	return best, nil
+}
+
+// retryable checks if the error is caused by the backend, rather than by the prompt itself.
+func retryable(err error) bool {
+	var serr *StatusError
+	if errors.As(err, &serr) {
+		return serr.Code >= 500
+	}
+	return true
+}
+
+func (p *Pool) promptRaw(ctx context.Context, prompt any, opts ...PromptOption) (*Prompt, error) {
+	nodes, err := decodePromptNodes(prompt)
+	if err != nil {
+		return nil, err
+	}
+	skip := make(map[*poolBackend]struct{})
+	for range p.retries {
+		b, err := p.pick(ctx, nodes, skip)
+		if err != nil {
+			return nil, err
+		}
+		pr, err := b.c.promptRaw(ctx, prompt, opts...)
+		if err == nil {
+			b.inflight.Add(1)
+			go func() {
+				<-pr.Done()
+				b.inflight.Add(-1)
+			}()
+			return pr, nil
+		}
+		if ctx.Err() != nil || !retryable(err) {
+			return nil, err
+		}
+		p.log.Warn("backend failed to start the prompt", "clientId", b.c.ID(), "err", err)
+		b.fail()
+		skip[b] = struct{}{}
+	}
+	return nil, ErrNoBackend
+}
+
+// PromptJSON starts the prompt on one of the backends.
+func (p *Pool) PromptJSON(ctx context.Context, prompt json.RawMessage, opts ...PromptOption) (*Prompt, error) {
+	return p.promptRaw(ctx, prompt, opts...)
+}
+
+// Prompt starts the prompt on one of the backends.
+func (p *Pool) Prompt(ctx context.Context, prompt *apigraph.Graph, opts ...PromptOption) (*Prompt, error) {
+	return p.promptRaw(ctx, prompt, opts...)
+}
+
+func (p *Pool) runPrompt(ctx context.Context, prompt any) (Results, error) {
+	var lastErr error
+	for range p.retries {
+		pr, err := p.promptRaw(ctx, prompt, WithoutEvents())
+		if err != nil {
+			return nil, err
+		}
+		err = pr.Wait(ctx)
+		pr.Close()
+		if err != nil {
+			return nil, err
+		}
+		if pr.c.Alive() {
+			return pr.Results(ctx)
+		}
+		// Backend disconnected while running the prompt, try another one.
+		lastErr = fmt.Errorf("backend %s disconnected", pr.c.ID())
+		p.log.Warn("backend disconnected while running the prompt", "clientId", pr.c.ID())
+	}
+	return nil, fmt.Errorf("%w: %w", ErrNoBackend, lastErr)
+}
+
+// RunPromptJSON runs the prompt on one of the backends and waits for the results.
+// If the backend disconnects while running the prompt, it is retried on another backend.
+func (p *Pool) RunPromptJSON(ctx context.Context, prompt json.RawMessage) (Results, error) {
+	return p.runPrompt(ctx, prompt)
+}
+
+// RunPrompt runs the prompt on one of the backends and waits for the results.
+// If the backend disconnects while running the prompt, it is retried on another backend.
+func (p *Pool) RunPrompt(ctx context.Context, prompt *apigraph.Graph) (Results, error) {
+	return p.runPrompt(ctx, prompt)
+}
@@ -0,0 +1,111 @@
+package gocomfy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+)
+
+// fakeBackend wraps fakeComfy, adding object_info with a class B that accepts given model,
+// and a class L that loads an uploaded image.
+func fakeBackend(t testing.TB, model string, prompts *atomic.Int32, fail bool) *Client {
+	var models atomic.Pointer[string]
+	models.Store(&model)
+	return fakeBackendModels(t, &models, prompts, nil, fail)
+}
+
+// fakeBackendModels is like fakeBackend, but allows changing the model list while the server is running.
+// If fetches is set, it counts object_info requests.
+func fakeBackendModels(t testing.TB, models *atomic.Pointer[string], prompts, fetches *atomic.Int32, fail bool) *Client {
+	base := fakeComfy(t, 0)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /object_info", func(w http.ResponseWriter, r *http.Request) {
+		if fetches != nil {
+			fetches.Add(1)
+		}
+		fmt.Fprintf(w, `{
+"B":{"name":"B","input":{"required":{"ckpt_name":[[%q],{}]}},"output":[],"output_name":[]},
+"L":{"name":"L","input":{"required":{"image":[["a.png"],{"image_upload":true}]}},"output":[],"output_name":[]}
+}`, *models.Load())
+	})
+	mux.HandleFunc("POST /prompt", func(w http.ResponseWriter, r *http.Request) {
+		prompts.Add(1)
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		base.ServeHTTP(w, r)
+	})
+	mux.Handle("/", base)
+	return fakeClient(t, mux, WithPollInterval(time.Millisecond, 5*time.Millisecond))
+}
+
+func TestPool(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var a, b, c atomic.Int32
+	pool := NewPool([]*Client{
+		fakeBackend(t, "other.safetensors", &a, false),
+		fakeBackend(t, "model.safetensors", &b, true),
+		fakeBackend(t, "model.safetensors", &c, false),
+	})
+
+	prompt := []byte(`{"9":{"class_type":"B","inputs":{"ckpt_name":"model.safetensors"}}}`)
+	for range 3 {
+		res, err := pool.RunPromptJSON(ctx, prompt)
+		must.NoError(t, err)
+		must.EqOp(t, "a.png", res[9].Images[0].Filename)
+	}
+	must.EqOp(t, 0, a.Load())
+	// Failed backend is tried at most once, and then skipped until the cooldown.
+	must.LessEq(t, 1, b.Load())
+	must.EqOp(t, 3, c.Load())
+
+	_, err := pool.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"C"}}`))
+	must.ErrorIs(t, err, ErrNoBackend)
+}
+
+func TestPoolRefreshClasses(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var (
+		prompts atomic.Int32
+		fetches atomic.Int32
+		models  atomic.Pointer[string]
+	)
+	model := "model.safetensors"
+	models.Store(&model)
+	pool := NewPool([]*Client{fakeBackendModels(t, &models, &prompts, &fetches, false)})
+	pool.valuesRefresh = 50 * time.Millisecond
+
+	_, err := pool.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"B","inputs":{"ckpt_name":"model.safetensors"}}}`))
+	must.NoError(t, err)
+	must.EqOp(t, 1, fetches.Load())
+
+	// The model is added after the classes were cached. It is not visible until the classes are refreshed,
+	// but repeated prompts do not fetch the classes again.
+	model2 := "new.safetensors"
+	models.Store(&model2)
+	prompt := []byte(`{"9":{"class_type":"B","inputs":{"ckpt_name":"new.safetensors"}}}`)
+	for range 3 {
+		_, err = pool.RunPromptJSON(ctx, prompt)
+		must.ErrorIs(t, err, ErrNoBackend)
+	}
+	must.EqOp(t, 1, fetches.Load())
+
+	time.Sleep(pool.valuesRefresh)
+	_, err = pool.RunPromptJSON(ctx, prompt)
+	must.NoError(t, err)
+	must.EqOp(t, 2, fetches.Load())
+
+	// Uploaded files are never listed in the cached options.
+	_, err = pool.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"L","inputs":{"image":"uploaded.png"}}}`))
+	must.NoError(t, err)
+	must.EqOp(t, 3, prompts.Load())
+	must.EqOp(t, 2, fetches.Load())
+}
@@ -0,0 +1,154 @@
+package gocomfy
+
+import (
+	"context"
+	"sync"
+)
+
+type promptOptions struct {
+	NoEvents bool
+}
+
+type PromptOption interface {
+	applyToPrompt(o *promptOptions)
+}
+
+type promptOptionFunc func(o *promptOptions)
+
+func (f promptOptionFunc) applyToPrompt(o *promptOptions) {
+	f(o)
+}
+
+// WithoutEvents disables event delivery for the prompt.
+//
+// Events channel is closed once the prompt completes, without sending any events.
+// Use Prompt.Wait or Prompt.Done to wait for the completion.
+func WithoutEvents() PromptOption {
+	return promptOptionFunc(func(o *promptOptions) {
+		o.NoEvents = true
+	})
+}
+
+// eventQueue is an unbounded per-prompt event queue.
+//
+// It decouples the websocket reader from the consumer of prompt events:
+// pushing an event never blocks, and a separate goroutine delivers queued events to the consumer.
+// The goroutine is only started when the consumer asks for the events, so unread events never keep it running.
+// Progress events that were not yet delivered are coalesced, so a slow consumer only sees the latest progress.
+type eventQueue struct {
+	mu      sync.Mutex
+	pending []Event
+	closed  bool
+
+	wake     chan struct{}
+	stop     chan struct{}
+	stopOnce sync.Once
+	runOnce  sync.Once
+	out      chan Event
+}
+
+func newEventQueue() *eventQueue {
+	return &eventQueue{
+		wake: make(chan struct{}, 1),
+		stop: make(chan struct{}),
+		out:  make(chan Event),
+	}
+}
+
+func (q *eventQueue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// push adds an event to the queue. It never blocks.
+func (q *eventQueue) push(e Event) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.pending = coalesceEvent(q.pending, e)
+	q.notify()
+}
+
+// coalesceEvent appends an event to the queue, replacing a pending progress event of the same kind.
+// Only progress events at the tail of the queue are considered, thus the order of other events is preserved.
+func coalesceEvent(pending []Event, e Event) []Event {
+	for i := len(pending) - 1; i >= 0; i-- {
+		var same bool
+		switch old := pending[i].(type) {
+		case NodeProg:
+			np, ok := e.(NodeProg)
+			same = ok && np.Path == old.Path
+		case ExecProg:
+			_, same = e.(ExecProg)
+		default:
+			return append(pending, e)
+		}
+		if same {
+			pending = append(pending[:i], pending[i+1:]...)
+			return append(pending, e)
+		}
+	}
+	return append(pending, e)
+}
+
+// close stops accepting new events. Pending events are still delivered, after which the output channel is closed.
+func (q *eventQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.notify()
+}
+
+// abort stops the delivery, dropping all pending events.
+func (q *eventQueue) abort() {
+	q.close()
+	q.stopOnce.Do(func() {
+		close(q.stop)
+	})
+}
+
+// start starts the delivery if it's not running yet and returns the output channel.
+func (q *eventQueue) start(ctx context.Context) <-chan Event {
+	q.runOnce.Do(func() {
+		go q.run(ctx)
+	})
+	return q.out
+}
+
+// run delivers events to the output channel until the queue is closed and drained, aborted or the context is cancelled.
+func (q *eventQueue) run(ctx context.Context) {
+	defer close(q.out)
+	for {
+		q.mu.Lock()
+		if len(q.pending) == 0 {
+			closed := q.closed
+			q.mu.Unlock()
+			if closed {
+				return
+			}
+			select {
+			case <-q.wake:
+				continue
+			case <-q.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+		e := q.pending[0]
+		q.pending[0] = nil
+		q.pending = q.pending[1:]
+		q.mu.Unlock()
+		select {
+		case q.out <- e:
+		case <-q.stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package gocomfy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+func TestEventQueueCoalesce(t *testing.T) {
+	q := newEventQueue()
+	q.push(ExecStart{})
+	q.push(NodeProg{Node: 3, Path: "3", Value: 1, Max: 10})
+	q.push(ExecProg{Progress{Fraction: 0.1}})
+	q.push(NodeProg{Node: 3, Path: "3", Value: 2, Max: 10})
+	q.push(NodeProg{Node: 5, Path: "5", Value: 1, Max: 2})
+	q.push(ExecProg{Progress{Fraction: 0.2}})
+	q.push(NodeDone{Node: 3, Path: "3"})
+	q.push(NodeProg{Node: 5, Path: "5", Value: 2, Max: 2})
+	q.close()
+
+	go q.run(context.Background())
+	var events []Event
+	for ev := range q.out {
+		events = append(events, ev)
+	}
+	must.Eq(t, []Event{
+		ExecStart{},
+		NodeProg{Node: 3, Path: "3", Value: 2, Max: 10},
+		NodeProg{Node: 5, Path: "5", Value: 1, Max: 2},
+		ExecProg{Progress{Fraction: 0.2}},
+		NodeDone{Node: 3, Path: "3"},
+		NodeProg{Node: 5, Path: "5", Value: 2, Max: 2},
+	}, events)
+}
+
+func TestRunPromptWithoutEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c := fakeClient(t, fakeComfy(t, 1), WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+	p, err := c.PromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`), WithoutEvents())
+	must.NoError(t, err)
+	must.NoError(t, p.Wait(ctx))
+	_, ok := <-p.Events()
+	must.False(t, ok)
+
+	res, err := c.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`))
+	must.NoError(t, err)
+	must.EqOp(t, "a.png", res[9].Images[0].Filename)
+}
+
+func TestPromptEventsAfterDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c := fakeClient(t, fakeComfy(t, 1), WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+	p, err := c.PromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`))
+	must.NoError(t, err)
+	must.NoError(t, p.Wait(ctx))
+	// Delivery only starts here, but none of the events are lost.
+	var got []Event
+	for ev := range p.Events() {
+		switch ev.(type) {
+		case ExecStart, NodeDone, ExecDone:
+			got = append(got, ev)
+		}
+	}
+	must.SliceNotEmpty(t, got)
+	must.Eq[Event](t, ExecStart{}, got[0])
+	must.Eq[Event](t, ExecDone{}, got[len(got)-1])
+}
+
+func TestPromptExecError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	events := make(chan wsconn.Event)
+	defer close(events)
+	cache := NewMemoryCache(10)
+	c := fakeWSClient(t, fakeComfy(t, 0), events, WithResultCache(cache))
+
+	base := wsconn.PromptEventBase{PromptID: "p1"}
+	node := wsconn.NodeID("9")
+	failed := []wsconn.PromptEvent{
+		&wsconn.ExecStart{PromptEventBase: base},
+		&wsconn.ExecNode{PromptEventBase: base, Node: &node},
+		&wsconn.ExecError{PromptEventBase: base, Node: node, NodeType: "B", ExceptionType: "RuntimeError", Exception: "boom"},
+		&wsconn.ExecNode{PromptEventBase: base},
+	}
+	exp := ExecError{Node: 9, Path: "9", NodeType: "B", ExceptionType: "RuntimeError", Exception: "boom"}
+
+	p, err := c.PromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`))
+	must.NoError(t, err)
+	go sendPromptEvents(c, "p1", events, failed...)
+	var got []Event
+	for ev := range p.Events() {
+		if _, ok := ev.(ExecProg); !ok {
+			got = append(got, ev)
+		}
+	}
+	must.Eq(t, []Event{ExecStart{}, NodeStart{Node: 9, Path: "9"}, exp}, got)
+	err = p.Wait(ctx)
+	must.EqError(t, err, "node 9 (B): RuntimeError: boom")
+
+	// failed prompts are not cached
+	go sendPromptEvents(c, "p1", events, failed...)
+	_, err = c.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`))
+	var e ExecError
+	must.True(t, errors.As(err, &e))
+	must.Eq(t, exp, e)
+	must.EqOp(t, 0, cache.Len())
+
+	// interrupted prompts too
+	go sendPromptEvents(c, "p1", events,
+		&wsconn.ExecStart{PromptEventBase: base},
+		&wsconn.ExecInterrupted{PromptEventBase: base, Node: node, NodeType: "B"},
+		&wsconn.ExecNode{PromptEventBase: base},
+	)
+	_, err = c.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`))
+	must.EqError(t, err, "node 9 (B): prompt execution interrupted")
+	must.EqOp(t, 0, cache.Len())
+
+	go sendPromptEvents(c, "p1", events,
+		&wsconn.ExecStart{PromptEventBase: base},
+		&wsconn.ExecNode{PromptEventBase: base, Node: &node},
+		&wsconn.ExecSuccess{PromptEventBase: base},
+		&wsconn.ExecNode{PromptEventBase: base},
+	)
+	res, err := c.RunPromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`))
+	must.NoError(t, err)
+	must.EqOp(t, "a.png", res[9].Images[0].Filename)
+	must.EqOp(t, 1, cache.Len())
+}
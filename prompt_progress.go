@@ -0,0 +1,219 @@
+package gocomfy
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+type NodeState int
+
+const (
+	NodePending = NodeState(iota)
+	NodeRunning
+	NodeFinished
+	NodeCached
+)
+
+func (s NodeState) String() string {
+	switch s {
+	case NodePending:
+		return "pending"
+	case NodeRunning:
+		return "running"
+	case NodeFinished:
+		return "finished"
+	case NodeCached:
+		return "cached"
+	}
+	return "unknown"
+}
+
+// IsDone checks if the node completed execution or was cached.
+func (s NodeState) IsDone() bool {
+	return s == NodeFinished || s == NodeCached
+}
+
+// NodeProgress is a state of a single node in the prompt.
+type NodeProgress struct {
+	Node  NodeID
+	Path  NodePath
+	State NodeState
+	Value int
+	Max   int
+}
+
+// weight returns the number of steps of the node. Nodes that do not report steps count as a single step.
+func (n *NodeProgress) weight() float64 {
+	return float64(max(n.Max, 1))
+}
+
+// done returns the completed fraction of the node.
+func (n *NodeProgress) done() float64 {
+	switch {
+	case n.State.IsDone():
+		return 1
+	case n.State == NodeRunning && n.Max > 0:
+		return min(float64(n.Value)/float64(n.Max), 1)
+	}
+	return 0
+}
+
+// Progress is a snapshot of the overall prompt progress.
+type Progress struct {
+	// Fraction is the completed part of the prompt, in range [0, 1].
+	//
+	// Nodes are weighted by the number of their steps, and running nodes contribute the fraction of completed steps.
+	// It never decreases, even if new nodes or steps are discovered during the execution.
+	Fraction float64
+	// Nodes is the state of each node in the prompt.
+	Nodes map[NodePath]NodeProgress
+	// Elapsed is the time since the execution started.
+	Elapsed time.Duration
+	// ETA is an estimated time until the prompt completes. It is zero if the estimate is not available.
+	ETA time.Duration
+}
+
+type progressTracker struct {
+	mu    sync.Mutex
+	start time.Time
+	nodes map[NodePath]*NodeProgress
+	last  float64
+}
+
+// seed marks all nodes of the prompt as pending, which makes the progress accurate
+// even if the server does not report the state of all nodes.
+func (t *progressTracker) seed(prompt any) {
+	t.nodes = make(map[NodePath]*NodeProgress)
+	var paths []NodePath
+	switch prompt := prompt.(type) {
+	case *apigraph.Graph:
+		for id := range prompt.Nodes {
+			paths = append(paths, NodePath(id.String()))
+		}
+	case json.RawMessage:
+		var m map[NodePath]json.RawMessage
+		if err := json.Unmarshal(prompt, &m); err != nil {
+			return
+		}
+		for path := range m {
+			paths = append(paths, path)
+		}
+	}
+	for _, path := range paths {
+		id, err := path.Root()
+		if err != nil {
+			continue
+		}
+		t.nodes[path] = &NodeProgress{Node: id, Path: path}
+	}
+}
+
+func (t *progressTracker) node(id NodeID, path NodePath) *NodeProgress {
+	n := t.nodes[path]
+	if n == nil {
+		n = &NodeProgress{Node: id, Path: path}
+		t.nodes[path] = n
+	}
+	return n
+}
+
+// fraction returns the overall progress. It is clamped to the last reported value, so it never decreases.
+func (t *progressTracker) fraction() float64 {
+	var done, total float64
+	for _, n := range t.nodes {
+		w := n.weight()
+		done += w * n.done()
+		total += w
+	}
+	if total == 0 {
+		return t.last
+	}
+	return max(done/total, t.last)
+}
+
+func (t *progressTracker) snapshot(now time.Time) Progress {
+	p := Progress{
+		Fraction: t.fraction(),
+		Nodes:    make(map[NodePath]NodeProgress, len(t.nodes)),
+	}
+	for path, n := range t.nodes {
+		p.Nodes[path] = *n
+	}
+	if !t.start.IsZero() {
+		p.Elapsed = now.Sub(t.start)
+		if p.Fraction > 0 && p.Fraction < 1 {
+			p.ETA = time.Duration(float64(p.Elapsed) * (1 - p.Fraction) / p.Fraction)
+		}
+	}
+	return p
+}
+
+// Progress returns a snapshot of the overall prompt progress.
+func (p *Prompt) Progress() Progress {
+	p.prog.mu.Lock()
+	defer p.prog.mu.Unlock()
+	return p.prog.snapshot(time.Now())
+}
+
+// progressed updates the progress state and emits ExecProg event if the overall progress changed.
+func (p *Prompt) progressed(fnc func(t *progressTracker)) error {
+	t := &p.prog
+	t.mu.Lock()
+	fnc(t)
+	f := t.fraction()
+	if f == t.last {
+		t.mu.Unlock()
+		return nil
+	}
+	t.last = f
+	snap := t.snapshot(time.Now())
+	t.mu.Unlock()
+	return p.event(ExecProg{Progress: snap})
+}
+
+func (p *Prompt) procProgressState(ev *wsconn.ProgressState) error {
+	nodes := make(map[NodePath]NodeProgress, len(ev.Nodes))
+	for key, st := range ev.Nodes {
+		node := st.Node
+		if node == "" {
+			node = key
+		}
+		display := &st.DisplayNode
+		id, path, err := nodePath(node, display)
+		if err != nil {
+			return err
+		}
+		n := NodeProgress{
+			Node:  id,
+			Path:  path,
+			Value: int(st.Value),
+			Max:   int(st.Max),
+		}
+		switch st.State {
+		case wsconn.StateRunning:
+			n.State = NodeRunning
+		case wsconn.StateFinished:
+			n.State = NodeFinished
+		}
+		nodes[path] = n
+	}
+	return p.progressed(func(t *progressTracker) {
+		for path, n := range nodes {
+			if cur := t.nodes[path]; cur != nil && cur.State == NodeCached {
+				continue
+			}
+			t.nodes[path] = &n
+		}
+	})
+}
+
+// ExecProg is emitted when the overall progress of the prompt changes.
+type ExecProg struct {
+	Progress
+}
+
+func (ExecProg) isEvent() {}
@@ -0,0 +1,58 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestProgressMonotonic(t *testing.T) {
+	p := &Prompt{ctx: context.Background(), events: newEventQueue()}
+	p.prog.seed(json.RawMessage(`{"3":{},"4":{},"5":{},"6":{}}`))
+	p.prog.start = time.Now()
+	var last float64
+	step := func(fnc func(t *progressTracker)) float64 {
+		must.NoError(t, p.progressed(fnc))
+		f := p.Progress().Fraction
+		must.GreaterEq(t, last, f)
+		last = f
+		return f
+	}
+	for _, path := range []NodePath{"4", "5", "6"} {
+		step(func(t *progressTracker) { t.node(0, path).State = NodeFinished })
+	}
+	must.EqOp(t, 0.75, last)
+
+	// starting a sampler does not move the progress back
+	f := step(func(t *progressTracker) {
+		n := t.node(3, "3")
+		n.State, n.Max = NodeRunning, 20
+	})
+	must.EqOp(t, 0.75, f)
+	f = step(func(t *progressTracker) { t.node(3, "3").Value = 10 })
+	must.EqOp(t, 0.75, f)
+	// nodes are weighted by steps
+	f = step(func(t *progressTracker) { t.node(3, "3").Value = 15 })
+	must.EqOp(t, 18.0/23, f)
+
+	// neither does discovering new nodes
+	f = step(func(t *progressTracker) { t.node(3, "3:1") })
+	must.EqOp(t, 18.0/23, f)
+	step(func(t *progressTracker) {
+		t.node(3, "3").State = NodeFinished
+		t.node(3, "3:1").State = NodeFinished
+	})
+	must.EqOp(t, 1.0, last)
+
+	// progress changes are reported as events
+	p.events.close()
+	var events []Event
+	for ev := range p.events.start(context.Background()) {
+		events = append(events, ev)
+	}
+	must.SliceLen(t, 1, events)
+	must.EqOp(t, 1.0, events[0].(ExecProg).Fraction)
+}
@@ -0,0 +1,233 @@
+package gocomfy
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Report is an execution timing report for the prompt.
+//
+// When the websocket is disabled, the server state is polled and execution times are only accurate to the poll interval.
+// Node timings are not available in this case, and Nodes is empty.
+type Report struct {
+	PromptID string `json:"prompt_id"`
+	// Submitted is the time the prompt was sent to the server.
+	Submitted time.Time `json:"submitted"`
+	// Started is the time the server started executing the prompt.
+	Started time.Time `json:"started"`
+	// Finished is the time the prompt completed.
+	Finished time.Time `json:"finished"`
+	// QueueWait is the time the prompt spent in the queue.
+	QueueWait time.Duration `json:"queue_wait"`
+	// Total is the time from the submission to the completion of the prompt.
+	Total time.Duration `json:"total"`
+	// Nodes contains timings of each node, ordered by the start time.
+	Nodes []NodeTiming `json:"nodes"`
+}
+
+// NodeTiming is an execution timing of a single node.
+type NodeTiming struct {
+	Node   NodeID   `json:"node"`
+	Path   NodePath `json:"path"`
+	Cached bool     `json:"cached,omitempty"`
+	// Start and End of the node execution. For cached nodes both are set to the time the cache hit was reported.
+	Start    time.Time     `json:"start"`
+	End      time.Time     `json:"end"`
+	Duration time.Duration `json:"duration"`
+	// Steps is the number of steps reported by the node, if any.
+	Steps int `json:"steps,omitempty"`
+	// StepRate is the number of steps per second, computed from progress events.
+	StepRate float64 `json:"step_rate,omitempty"`
+}
+
+type nodeTiming struct {
+	NodeTiming
+	firstProg  time.Time
+	firstValue int
+	lastProg   time.Time
+	lastValue  int
+}
+
+type timingRecorder struct {
+	mu        sync.Mutex
+	submitted time.Time
+	// polling is set if the prompt state is polled, and node timings are not reported.
+	polling  bool
+	started  time.Time
+	finished time.Time
+	nodes    map[NodePath]*nodeTiming
+}
+
+func (r *timingRecorder) node(id NodeID, path NodePath, now time.Time) *nodeTiming {
+	if r.nodes == nil {
+		r.nodes = make(map[NodePath]*nodeTiming)
+	}
+	n := r.nodes[path]
+	if n == nil {
+		n = &nodeTiming{NodeTiming: NodeTiming{Node: id, Path: path, Start: now}}
+		r.nodes[path] = n
+	}
+	return n
+}
+
+func (r *timingRecorder) execStart(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = now
+}
+
+func (r *timingRecorder) execDone(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.finished = now
+}
+
+func (r *timingRecorder) nodeCached(id NodeID, path NodePath, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := r.node(id, path, now)
+	n.Cached = true
+	n.End = now
+}
+
+func (r *timingRecorder) nodeStart(id NodeID, path NodePath, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.node(id, path, now)
+}
+
+func (r *timingRecorder) nodeProg(id NodeID, path NodePath, value, max int, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := r.node(id, path, now)
+	if n.firstProg.IsZero() {
+		n.firstProg, n.firstValue = now, value
+	}
+	n.lastProg, n.lastValue = now, value
+	n.Steps = max
+}
+
+func (r *timingRecorder) nodeDone(id NodeID, path NodePath, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := r.node(id, path, now)
+	if n.End.IsZero() {
+		n.End = now
+	}
+}
+
+func (r *timingRecorder) report(pid string) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	nodes := r.nodes
+	if r.polling {
+		nodes = nil
+	}
+	rep := &Report{
+		PromptID:  pid,
+		Submitted: r.submitted,
+		Started:   r.started,
+		Finished:  r.finished,
+		Nodes:     make([]NodeTiming, 0, len(nodes)),
+	}
+	if !r.started.IsZero() {
+		rep.QueueWait = r.started.Sub(r.submitted)
+	}
+	if !r.finished.IsZero() {
+		rep.Total = r.finished.Sub(r.submitted)
+	}
+	for _, n := range nodes {
+		t := n.NodeTiming
+		if !t.End.IsZero() {
+			t.Duration = t.End.Sub(t.Start)
+		}
+		if dt := n.lastProg.Sub(n.firstProg); dt > 0 {
+			t.StepRate = float64(n.lastValue-n.firstValue) / dt.Seconds()
+		}
+		rep.Nodes = append(rep.Nodes, t)
+	}
+	slices.SortFunc(rep.Nodes, func(a, b NodeTiming) int {
+		if c := a.Start.Compare(b.Start); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.Path), string(b.Path))
+	})
+	return rep
+}
+
+// Report returns the execution timing report for the prompt.
+// It can be called at any time, but the report is only complete after ExecDone event.
+func (p *Prompt) Report() *Report {
+	return p.timing.report(p.pid)
+}
+
+// WriteJSON writes the report in JSON format.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(r)
+}
+
+type traceEvent struct {
+	Name  string         `json:"name"`
+	Cat   string         `json:"cat"`
+	Phase string         `json:"ph"`
+	TS    int64          `json:"ts"`
+	Dur   int64          `json:"dur,omitempty"`
+	PID   int            `json:"pid"`
+	TID   int            `json:"tid"`
+	Scope string         `json:"s,omitempty"`
+	Args  map[string]any `json:"args,omitempty"`
+}
+
+// WriteChromeTrace writes the report in Chrome trace event format.
+// The file can be opened in chrome://tracing or https://ui.perfetto.dev.
+func (r *Report) WriteChromeTrace(w io.Writer) error {
+	base := r.Submitted
+	ts := func(t time.Time) int64 {
+		return t.Sub(base).Microseconds()
+	}
+	events := make([]traceEvent, 0, len(r.Nodes)+2)
+	if !r.Started.IsZero() {
+		events = append(events, traceEvent{
+			Name: "queue", Cat: "prompt", Phase: "X", PID: 1, TID: 0,
+			TS: 0, Dur: r.QueueWait.Microseconds(),
+		})
+	}
+	if !r.Finished.IsZero() && !r.Started.IsZero() {
+		events = append(events, traceEvent{
+			Name: "execution", Cat: "prompt", Phase: "X", PID: 1, TID: 0,
+			TS: ts(r.Started), Dur: r.Finished.Sub(r.Started).Microseconds(),
+			Args: map[string]any{"prompt_id": r.PromptID},
+		})
+	}
+	for _, n := range r.Nodes {
+		args := map[string]any{"node": n.Node, "path": n.Path}
+		if n.Steps != 0 {
+			args["steps"] = n.Steps
+		}
+		if n.StepRate != 0 {
+			args["step_rate"] = n.StepRate
+		}
+		if n.Cached {
+			events = append(events, traceEvent{
+				Name: "cached " + string(n.Path), Cat: "node", Phase: "i", Scope: "t", PID: 1, TID: 1,
+				TS: ts(n.Start), Args: args,
+			})
+			continue
+		}
+		events = append(events, traceEvent{
+			Name: "node " + string(n.Path), Cat: "node", Phase: "X", PID: 1, TID: 1,
+			TS: ts(n.Start), Dur: n.Duration.Microseconds(), Args: args,
+		})
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Events []traceEvent `json:"traceEvents"`
+	}{
+		Events: events,
+	})
+}
@@ -0,0 +1,63 @@
+package gocomfy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestReport(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(ms int) time.Time {
+		return t0.Add(time.Duration(ms) * time.Millisecond)
+	}
+	r := timingRecorder{submitted: t0}
+	r.execStart(at(100))
+	r.nodeCached(4, "4", at(110))
+	r.nodeStart(3, "3", at(120))
+	r.nodeProg(3, "3", 1, 20, at(200))
+	r.nodeProg(3, "3", 11, 20, at(1200))
+	r.nodeDone(3, "3", at(1300))
+	r.nodeStart(9, "9", at(1300))
+	r.nodeDone(9, "9", at(1400))
+	r.execDone(at(1500))
+
+	rep := r.report("p1")
+	must.EqOp(t, 100*time.Millisecond, rep.QueueWait)
+	must.EqOp(t, 1500*time.Millisecond, rep.Total)
+	must.Eq(t, []NodeTiming{
+		{Node: 4, Path: "4", Cached: true, Start: at(110), End: at(110)},
+		{Node: 3, Path: "3", Start: at(120), End: at(1300), Duration: 1180 * time.Millisecond, Steps: 20, StepRate: 10},
+		{Node: 9, Path: "9", Start: at(1300), End: at(1400), Duration: 100 * time.Millisecond},
+	}, rep.Nodes)
+
+	var buf bytes.Buffer
+	must.NoError(t, rep.WriteChromeTrace(&buf))
+	var trace struct {
+		Events []traceEvent `json:"traceEvents"`
+	}
+	must.NoError(t, json.Unmarshal(buf.Bytes(), &trace))
+	must.Len(t, 5, trace.Events)
+	must.EqOp(t, "node 3", trace.Events[3].Name)
+	must.EqOp(t, int64(120_000), trace.Events[3].TS)
+	must.EqOp(t, int64(1_180_000), trace.Events[3].Dur)
+}
+
+func TestReportPolling(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c := fakeClient(t, fakeComfy(t, 1), WithPollInterval(time.Millisecond, 5*time.Millisecond))
+
+	p, err := c.PromptJSON(ctx, []byte(`{"9":{"class_type":"B"}}`), WithoutEvents())
+	must.NoError(t, err)
+	must.NoError(t, p.Wait(ctx))
+	rep := p.Report()
+	must.False(t, rep.Started.IsZero())
+	must.False(t, rep.Finished.IsZero())
+	// node timings are not reported by the server
+	must.SliceEmpty(t, rep.Nodes)
+}
@@ -0,0 +1,307 @@
+package gocomfy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+)
+
+// Priority of a scheduled task. Tasks with higher priority are submitted first.
+type Priority int
+
+const (
+	PriorityBatch       = Priority(-10)
+	PriorityNormal      = Priority(0)
+	PriorityInteractive = Priority(10)
+)
+
+// Task is a prompt scheduled for execution.
+type Task struct {
+	// Tenant identifies the owner of the task. Tasks with the same priority are taken from each tenant in turn.
+	Tenant   string
+	Priority Priority
+	// Graph is the prompt to execute.
+	Graph *apigraph.Graph
+	// JSON is the prompt to execute, used if Graph is not set.
+	JSON json.RawMessage
+}
+
+func (t *Task) prompt() any {
+	if t.Graph != nil {
+		return t.Graph
+	}
+	return t.JSON
+}
+
+type schedulerOptions struct {
+	MaxInFlight int
+}
+
+type SchedulerOption interface {
+	applyToScheduler(o *schedulerOptions)
+}
+
+type schedulerOptionFunc func(o *schedulerOptions)
+
+func (f schedulerOptionFunc) applyToScheduler(o *schedulerOptions) {
+	f(o)
+}
+
+// WithMaxInFlight sets the max number of prompts submitted to each backend at the same time. Default is 1.
+func WithMaxInFlight(n int) SchedulerOption {
+	return schedulerOptionFunc(func(o *schedulerOptions) {
+		o.MaxInFlight = n
+	})
+}
+
+// ErrSchedulerClosed is returned for tasks that were still queued when the scheduler was closed.
+var ErrSchedulerClosed = errors.New("scheduler closed")
+
+// Scheduler queues prompts on the client side and submits them to backends only as capacity frees.
+//
+// Tasks with higher priority are always submitted first. Tasks with the same priority are taken from each tenant
+// in turn, and in FIFO order for each tenant. Tasks are removed from the queue when their context is cancelled
+// or its deadline expires. Once the prompt is submitted, cancelling the context cancels the prompt on the server.
+type Scheduler struct {
+	maxInFlight int
+
+	mu       sync.Mutex
+	closed   bool
+	backends []*schedBackend
+	levels   []*schedLevel // sorted by priority, highest first
+}
+
+type schedBackend struct {
+	c        *Client
+	inflight int
+}
+
+type schedLevel struct {
+	prio    Priority
+	tenants []string // round-robin order
+	next    int
+	queues  map[string][]*schedWaiter
+}
+
+type schedWaiter struct {
+	task  *Task
+	ready chan *schedBackend // closed if the scheduler is closed
+}
+
+// NewScheduler creates a scheduler that submits prompts to given clients.
+func NewScheduler(clients []*Client, opts ...SchedulerOption) *Scheduler {
+	opt := schedulerOptions{MaxInFlight: 1}
+	for _, o := range opts {
+		o.applyToScheduler(&opt)
+	}
+	s := &Scheduler{maxInFlight: max(opt.MaxInFlight, 1)}
+	for _, c := range clients {
+		s.backends = append(s.backends, &schedBackend{c: c})
+	}
+	return s
+}
+
+// Pending returns the number of queued tasks that were not yet submitted.
+func (s *Scheduler) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, l := range s.levels {
+		for _, q := range l.queues {
+			n += len(q)
+		}
+	}
+	return n
+}
+
+// Close fails all queued tasks. Submitted prompts are not affected.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for _, l := range s.levels {
+		for _, q := range l.queues {
+			for _, w := range q {
+				close(w.ready)
+			}
+		}
+	}
+	s.levels = nil
+}
+
+func (s *Scheduler) level(prio Priority) *schedLevel {
+	i, ok := slices.BinarySearchFunc(s.levels, prio, func(l *schedLevel, p Priority) int {
+		return int(p) - int(l.prio) // descending
+	})
+	if ok {
+		return s.levels[i]
+	}
+	l := &schedLevel{prio: prio, queues: make(map[string][]*schedWaiter)}
+	s.levels = slices.Insert(s.levels, i, l)
+	return l
+}
+
+func (s *Scheduler) enqueue(w *schedWaiter) {
+	l := s.level(w.task.Priority)
+	q, ok := l.queues[w.task.Tenant]
+	if !ok {
+		l.tenants = append(l.tenants, w.task.Tenant)
+	}
+	l.queues[w.task.Tenant] = append(q, w)
+}
+
+// remove deletes the waiter from the queue. It returns false if the waiter was already dequeued.
+func (s *Scheduler) remove(w *schedWaiter) bool {
+	for _, l := range s.levels {
+		if l.prio != w.task.Priority {
+			continue
+		}
+		q := l.queues[w.task.Tenant]
+		i := slices.Index(q, w)
+		if i < 0 {
+			return false
+		}
+		l.queues[w.task.Tenant] = slices.Delete(q, i, i+1)
+		l.compact()
+		return true
+	}
+	return false
+}
+
+// compact removes tenants without queued tasks.
+func (l *schedLevel) compact() {
+	for i := 0; i < len(l.tenants); i++ {
+		t := l.tenants[i]
+		if len(l.queues[t]) != 0 {
+			continue
+		}
+		delete(l.queues, t)
+		l.tenants = slices.Delete(l.tenants, i, i+1)
+		if l.next > i {
+			l.next--
+		}
+		i--
+	}
+	if l.next >= len(l.tenants) {
+		l.next = 0
+	}
+}
+
+// pop takes the next task, rotating between tenants.
+func (l *schedLevel) pop() *schedWaiter {
+	if len(l.tenants) == 0 {
+		return nil
+	}
+	t := l.tenants[l.next]
+	q := l.queues[t]
+	w := q[0]
+	l.queues[t] = q[1:]
+	l.next++
+	l.compact()
+	return w
+}
+
+// freeBackend returns the least loaded backend with free capacity.
+func (s *Scheduler) freeBackend() *schedBackend {
+	var best *schedBackend
+	for _, b := range s.backends {
+		if b.inflight >= s.maxInFlight || !b.c.Alive() {
+			continue
+		}
+		if best == nil || b.inflight < best.inflight {
+			best = b
+		}
+	}
+	return best
+}
+
+// dispatch assigns queued tasks to backends with free capacity.
+func (s *Scheduler) dispatch() {
+	for len(s.levels) != 0 {
+		b := s.freeBackend()
+		if b == nil {
+			return
+		}
+		l := s.levels[0]
+		w := l.pop()
+		if len(l.tenants) == 0 {
+			s.levels = s.levels[1:]
+		}
+		if w == nil {
+			continue
+		}
+		b.inflight++
+		w.ready <- b
+	}
+}
+
+func (s *Scheduler) release(b *schedBackend) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b.inflight--
+	s.dispatch()
+}
+
+// Prompt queues the task and waits until it is submitted to one of the backends.
+func (s *Scheduler) Prompt(ctx context.Context, t Task, opts ...PromptOption) (*Prompt, error) {
+	w := &schedWaiter{task: &t, ready: make(chan *schedBackend, 1)}
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, ErrSchedulerClosed
+	}
+	s.enqueue(w)
+	s.dispatch()
+	s.mu.Unlock()
+
+	var b *schedBackend
+	select {
+	case <-ctx.Done():
+		s.mu.Lock()
+		removed := s.remove(w)
+		s.mu.Unlock()
+		if !removed {
+			// Already dispatched, return the slot.
+			if b, ok := <-w.ready; ok {
+				s.release(b)
+			}
+		}
+		return nil, ctx.Err()
+	case b = <-w.ready:
+		if b == nil {
+			return nil, ErrSchedulerClosed
+		}
+	}
+	p, err := b.c.promptRaw(ctx, t.prompt(), opts...)
+	if err != nil {
+		s.release(b)
+		return nil, err
+	}
+	go func() {
+		<-p.Done()
+		s.release(b)
+	}()
+	return p, nil
+}
+
+// Run queues the task and waits for its results.
+//
+// Unlike Client.RunPrompt, it always runs the prompt, ignoring the result caches of the clients.
+func (s *Scheduler) Run(ctx context.Context, t Task) (Results, error) {
+	p, err := s.Prompt(ctx, t, WithoutEvents())
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close()
+	if err = p.Wait(ctx); err != nil {
+		return nil, err
+	}
+	if !p.completed() {
+		return nil, errors.New("connection lost before the prompt completed")
+	}
+	return p.Results(ctx)
+}
@@ -0,0 +1,102 @@
+package gocomfy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+func TestSchedulerOrder(t *testing.T) {
+	c := fakeClient(t, http.NotFoundHandler())
+	s := NewScheduler([]*Client{c})
+	s.backends[0].inflight = 1 // hold the slot until all tasks are queued
+
+	tasks := []Task{
+		{Tenant: "a", Priority: PriorityBatch, JSON: []byte(`"a1"`)},
+		{Tenant: "a", Priority: PriorityBatch, JSON: []byte(`"a2"`)},
+		{Tenant: "a", Priority: PriorityBatch, JSON: []byte(`"a3"`)},
+		{Tenant: "b", Priority: PriorityBatch, JSON: []byte(`"b1"`)},
+		{Tenant: "c", Priority: PriorityInteractive, JSON: []byte(`"c1"`)},
+		{Tenant: "b", Priority: PriorityBatch, JSON: []byte(`"b2"`)},
+		{Tenant: "a", Priority: PriorityNormal, JSON: []byte(`"a4"`)},
+	}
+	var waiters []*schedWaiter
+	for i := range tasks {
+		w := &schedWaiter{task: &tasks[i], ready: make(chan *schedBackend, 1)}
+		waiters = append(waiters, w)
+		s.enqueue(w)
+	}
+	must.EqOp(t, len(tasks), s.Pending())
+
+	var order []string
+	for range tasks {
+		s.release(s.backends[0])
+		for _, w := range waiters {
+			select {
+			case <-w.ready:
+				order = append(order, string(w.task.JSON))
+			default:
+			}
+		}
+	}
+	must.Eq(t, []string{`"c1"`, `"a4"`, `"a1"`, `"b1"`, `"a2"`, `"b2"`, `"a3"`}, order)
+	must.EqOp(t, 0, s.Pending())
+}
+
+func TestSchedulerCancel(t *testing.T) {
+	s := NewScheduler(nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := s.Prompt(ctx, Task{JSON: []byte(`{}`)})
+	must.ErrorIs(t, err, context.DeadlineExceeded)
+	must.EqOp(t, 0, s.Pending())
+
+	go func() {
+		for s.Pending() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		s.Close()
+	}()
+	_, err = s.Prompt(context.Background(), Task{JSON: []byte(`{}`)})
+	must.ErrorIs(t, err, ErrSchedulerClosed)
+}
+
+func TestSchedulerRun(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c := fakeClient(t, fakeComfy(t, 1), WithPollInterval(time.Millisecond, 5*time.Millisecond))
+	s := NewScheduler([]*Client{c})
+	res, err := s.Run(ctx, Task{Tenant: "a", JSON: []byte(`{"9":{"class_type":"B"}}`)})
+	must.NoError(t, err)
+	must.EqOp(t, "a.png", res[9].Images[0].Filename)
+	// Slot is released asynchronously after the prompt completes.
+	for {
+		s.mu.Lock()
+		n := s.backends[0].inflight
+		s.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedulerRunConnLost(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	events := make(chan wsconn.Event)
+	c := fakeWSClient(t, fakeComfy(t, 0), events)
+	s := NewScheduler([]*Client{c})
+	go func() {
+		sendPromptEvents(c, "p1", events, &wsconn.ExecStart{PromptEventBase: wsconn.PromptEventBase{PromptID: "p1"}})
+		// drop the connection before the prompt completes
+		close(events)
+	}()
+	_, err := s.Run(ctx, Task{Tenant: "a", JSON: []byte(`{"9":{"class_type":"B"}}`)})
+	must.EqError(t, err, "connection lost before the prompt completed")
+}
@@ -0,0 +1,276 @@
+package gocomfy
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/dennwc/gocomfy/graph/apigraph"
+)
+
+const defaultSinkConcurrency = 4
+
+// Output describes a single output file of the prompt.
+type Output struct {
+	Node NodeID
+	Path NodePath
+	// Index of the file in the node outputs.
+	Index int
+	Ref   ImageRef
+	// ContentType reported by the server.
+	ContentType string
+}
+
+// OutputSink persists prompt outputs.
+type OutputSink interface {
+	// WriteOutput stores the content of the output file. It may be called concurrently.
+	WriteOutput(ctx context.Context, out Output, r io.Reader) error
+}
+
+// StoredOutput is an output written to the sink.
+type StoredOutput struct {
+	Output
+	Size int64
+	// SHA256 is a hex-encoded checksum of the content.
+	SHA256 string
+}
+
+type sinkOptions struct {
+	Concurrency int
+}
+
+type SinkOption interface {
+	applyToSink(o *sinkOptions)
+}
+
+type sinkOptionFunc func(o *sinkOptions)
+
+func (f sinkOptionFunc) applyToSink(o *sinkOptions) {
+	f(o)
+}
+
+// WithSinkConcurrency sets the max number of outputs downloaded at the same time.
+func WithSinkConcurrency(n int) SinkOption {
+	return sinkOptionFunc(func(o *sinkOptions) {
+		o.Concurrency = n
+	})
+}
+
+func (c *Client) runPromptTo(ctx context.Context, prompt any, sink OutputSink, opts []SinkOption) ([]StoredOutput, error) {
+	opt := sinkOptions{Concurrency: defaultSinkConcurrency}
+	for _, o := range opts {
+		o.applyToSink(&opt)
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	p, err := c.promptRaw(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close()
+
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, max(opt.Concurrency, 1))
+		mu   sync.Mutex
+		out  []StoredOutput
+		ferr error
+	)
+	download := func(o Output) {
+		defer wg.Done()
+		defer func() { <-sem }()
+		st, err := c.storeOutput(ctx, sink, o)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			if ferr == nil {
+				ferr = err
+				cancel(err)
+			}
+			return
+		}
+		out = append(out, *st)
+	}
+loop:
+	for ev := range p.Events() {
+		ev, ok := ev.(NodeDone)
+		if !ok {
+			continue
+		}
+		for i, ref := range ev.Files() {
+			select {
+			case <-ctx.Done():
+				break loop
+			case sem <- struct{}{}:
+			}
+			wg.Add(1)
+			go download(Output{Node: ev.Node, Path: ev.Path, Index: i, Ref: ref})
+		}
+	}
+	wg.Wait()
+	if ferr != nil {
+		return nil, ferr
+	}
+	if err = context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	if err = p.Wait(ctx); err != nil {
+		return nil, err
+	}
+	if !p.completed() {
+		return nil, errors.New("connection lost before the prompt completed")
+	}
+	slices.SortFunc(out, func(a, b StoredOutput) int {
+		return cmp.Or(strings.Compare(string(a.Path), string(b.Path)), a.Index-b.Index)
+	})
+	return out, nil
+}
+
+func (c *Client) storeOutput(ctx context.Context, sink OutputSink, o Output) (*StoredOutput, error) {
+	rc, typ, err := c.GetImageFile(ctx, o.Ref)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	o.ContentType = typ
+	h := sha256.New()
+	cr := &countingReader{r: io.TeeReader(rc, h)}
+	if err = sink.WriteOutput(ctx, o, cr); err != nil {
+		return nil, err
+	}
+	return &StoredOutput{
+		Output: o,
+		Size:   cr.n,
+		SHA256: hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// RunPromptTo runs the prompt and writes all outputs to the sink.
+//
+// Outputs are downloaded as soon as the node that produced them completes, while the rest of the prompt is still running.
+func (c *Client) RunPromptTo(ctx context.Context, prompt *apigraph.Graph, sink OutputSink, opts ...SinkOption) ([]StoredOutput, error) {
+	return c.runPromptTo(ctx, prompt, sink, opts)
+}
+
+// RunPromptJSONTo runs the prompt and writes all outputs to the sink. See RunPromptTo.
+func (c *Client) RunPromptJSONTo(ctx context.Context, prompt json.RawMessage, sink OutputSink, opts ...SinkOption) ([]StoredOutput, error) {
+	return c.runPromptTo(ctx, prompt, sink, opts)
+}
+
+// DefaultOutputName is the default file name template used by DirSink.
+const DefaultOutputName = "{{.Node}}_{{.Index}}_{{.Ref.Filename}}"
+
+// DirSink writes outputs to a local directory.
+type DirSink struct {
+	dir  string
+	name *template.Template
+}
+
+var _ OutputSink = (*DirSink)(nil)
+
+// NewDirSink creates a sink that writes outputs to a given directory.
+//
+// File names are generated from the Go template executed with Output as an argument, see DefaultOutputName.
+// Names may contain slashes to write outputs to subdirectories.
+func NewDirSink(dir string, name string) (*DirSink, error) {
+	if name == "" {
+		name = DefaultOutputName
+	}
+	t, err := template.New("name").Parse(name)
+	if err != nil {
+		return nil, err
+	}
+	return &DirSink{dir: dir, name: t}, nil
+}
+
+// OutputPath returns the path of the output file.
+func (s *DirSink) OutputPath(out Output) (string, error) {
+	var buf bytes.Buffer
+	if err := s.name.Execute(&buf, out); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.Clean("/"+buf.String())), nil
+}
+
+func (s *DirSink) WriteOutput(ctx context.Context, out Output, r io.Reader) error {
+	path, err := s.OutputPath(out)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that partial downloads are never visible.
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// MemoryFile is an output stored in memory.
+type MemoryFile struct {
+	Output
+	Data []byte
+}
+
+// MemorySink keeps outputs in memory.
+type MemorySink struct {
+	mu    sync.Mutex
+	files []MemoryFile
+}
+
+var _ OutputSink = (*MemorySink)(nil)
+
+func (s *MemorySink) WriteOutput(ctx context.Context, out Output, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files = append(s.files, MemoryFile{Output: out, Data: data})
+	return nil
+}
+
+// Files returns all stored outputs, ordered by node path and index.
+func (s *MemorySink) Files() []MemoryFile {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := slices.Clone(s.files)
+	slices.SortFunc(files, func(a, b MemoryFile) int {
+		return cmp.Or(strings.Compare(string(a.Path), string(b.Path)), a.Index-b.Index)
+	})
+	return files
+}
@@ -0,0 +1,69 @@
+package gocomfy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/dennwc/gocomfy/wsconn"
+)
+
+func TestRunPromptTo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /view", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("data:" + r.URL.Query().Get("filename")))
+	})
+	mux.Handle("/", fakeComfy(t, 1))
+	c := fakeClient(t, mux, WithPollInterval(time.Millisecond, 5*time.Millisecond))
+	prompt := []byte(`{"9":{"class_type":"B"},"12:5":{"class_type":"C"}}`)
+
+	var mem MemorySink
+	out, err := c.RunPromptJSONTo(ctx, prompt, &mem, WithSinkConcurrency(1))
+	must.NoError(t, err)
+	must.Len(t, 2, out)
+	sum := sha256.Sum256([]byte("data:b.png"))
+	must.Eq(t, StoredOutput{
+		Output: Output{Node: 12, Path: "12:5", Ref: ImageRef{Filename: "b.png", Type: ImageOutput}, ContentType: "image/png"},
+		Size:   10,
+		SHA256: hex.EncodeToString(sum[:]),
+	}, out[0])
+	files := mem.Files()
+	must.Len(t, 2, files)
+	must.EqOp(t, "data:b.png", string(files[0].Data))
+	must.EqOp(t, "data:a.png", string(files[1].Data))
+
+	dir := t.TempDir()
+	sink, err := NewDirSink(dir, "{{.Node}}/{{.Ref.Filename}}")
+	must.NoError(t, err)
+	_, err = c.RunPromptJSONTo(ctx, prompt, sink)
+	must.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(dir, "9", "a.png"))
+	must.NoError(t, err)
+	must.EqOp(t, "data:a.png", string(data))
+
+	// outputs of a failed prompt are an error
+	events := make(chan wsconn.Event)
+	defer close(events)
+	c = fakeWSClient(t, mux, events)
+	base := wsconn.PromptEventBase{PromptID: "p1"}
+	node := wsconn.NodeID("9")
+	go sendPromptEvents(c, "p1", events,
+		&wsconn.ExecStart{PromptEventBase: base},
+		&wsconn.ExecNode{PromptEventBase: base, Node: &node},
+		&wsconn.ExecNodeDone{PromptEventBase: base, Node: node, Output: wsconn.NodeOutput{Images: []ImageRef{{Filename: "a.png", Type: ImageOutput}}}},
+		&wsconn.ExecError{PromptEventBase: base, Node: "12:5", NodeType: "C", ExceptionType: "RuntimeError", Exception: "boom"},
+		&wsconn.ExecNode{PromptEventBase: base},
+	)
+	_, err = c.RunPromptJSONTo(ctx, prompt, &mem)
+	must.EqError(t, err, "node 12:5 (C): RuntimeError: boom")
+}
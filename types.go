@@ -1,5 +1,16 @@
 package gocomfy
 
-import "github.com/dennwc/gocomfy/graph/types"
+import (
+	"github.com/dennwc/gocomfy/graph/types"
+	ctypes "github.com/dennwc/gocomfy/types"
+)
 
 type NodeID = types.NodeID
+
+type NodePath = types.NodePath
+
+type NodeResult = ctypes.NodeResult
+
+type Results = ctypes.Results
+
+type PathResults = ctypes.PathResults
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 )
 
 type ImageType string
@@ -13,6 +14,14 @@ const (
 	ImageOutput = ImageType("output")
 )
 
+// PreviewFormat is an image format for previews generated by ComfyUI.
+type PreviewFormat string
+
+const (
+	PreviewWebP = PreviewFormat("webp")
+	PreviewJPEG = PreviewFormat("jpeg")
+)
+
 type ImageRef struct {
 	Filename  string    `json:"filename"`
 	Subfolder string    `json:"subfolder"`
@@ -29,6 +38,16 @@ func (r ImageRef) SetURL(vals url.Values) {
 	}
 }
 
+// WithPreview returns a reference that requests a preview of the image in a given format instead of the original file.
+// Quality is in range 1-100, zero value means the server default.
+func (r ImageRef) WithPreview(format PreviewFormat, quality int) ImageRef {
+	r.Preview = string(format)
+	if quality > 0 {
+		r.Preview += ";" + strconv.Itoa(quality)
+	}
+	return r
+}
+
 // AnnotatedPath returns annotated path that is accepted by ComfyUI image load nodes.
 func (r ImageRef) AnnotatedPath() string {
 	if r.Subfolder != "" {
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"maps"
+	"slices"
+
+	graph "github.com/dennwc/gocomfy/graph/types"
+)
+
+// NodeResult are outputs of a single node.
+type NodeResult struct {
+	Images []ImageRef `json:"images,omitempty"`
+	Audio  []ImageRef `json:"audio,omitempty"`
+	Text   []string   `json:"text,omitempty"`
+	// Animated is set if images are animations or videos.
+	Animated bool `json:"animated,omitempty"`
+}
+
+// Files returns all output files of the node.
+func (r NodeResult) Files() []ImageRef {
+	return slices.Concat(r.Images, r.Audio)
+}
+
+// Results are outputs of the prompt, keyed by top-level node IDs.
+// Outputs of nodes inside subgraphs are merged into the results of the containing top-level node.
+type Results map[graph.NodeID]NodeResult
+
+// PathResults are outputs of the prompt, keyed by full node paths, including nodes inside subgraphs.
+type PathResults map[graph.NodePath]NodeResult
+
+// ByNode merges outputs of nodes inside subgraphs into the results of the containing top-level nodes.
+func (r PathResults) ByNode() (Results, error) {
+	out := make(Results, len(r))
+	// merge in a stable order
+	paths := slices.Collect(maps.Keys(r))
+	slices.Sort(paths)
+	for _, path := range paths {
+		id, err := path.Root()
+		if err != nil {
+			return nil, err
+		}
+		res, sub := out[id], r[path]
+		res.Images = append(res.Images, sub.Images...)
+		res.Audio = append(res.Audio, sub.Audio...)
+		res.Text = append(res.Text, sub.Text...)
+		res.Animated = res.Animated || sub.Animated
+		out[id] = res
+	}
+	return out, nil
+}
@@ -0,0 +1,12 @@
+package wsconn
+
+func init() {
+	RegisterEvent[*FeatureFlags]()
+}
+
+// FeatureFlags is sent by the client and the server to negotiate optional protocol features.
+type FeatureFlags map[string]any
+
+func (*FeatureFlags) EventType() string {
+	return "feature_flags"
+}
@@ -0,0 +1,64 @@
+package wsconn
+
+import (
+	"encoding/json"
+	"time"
+)
+
+func init() {
+	RegisterEvent[*LogsEvent]()
+}
+
+// LogsEvent is sent to clients subscribed to server logs.
+type LogsEvent struct {
+	Entries []LogEntry `json:"entries"`
+	Size    *TermSize  `json:"size,omitempty"`
+}
+
+func (*LogsEvent) EventType() string {
+	return "logs"
+}
+
+type TermSize struct {
+	Cols int `json:"cols"`
+	Rows int `json:"rows"`
+}
+
+var _ json.Unmarshaler = (*LogEntry)(nil)
+
+type LogEntry struct {
+	Time    time.Time
+	Message string
+}
+
+type jsonLogEntry struct {
+	Time    string `json:"t"`
+	Message string `json:"m"`
+}
+
+func (e *LogEntry) UnmarshalJSON(data []byte) error {
+	var je jsonLogEntry
+	if err := json.Unmarshal(data, &je); err != nil {
+		return err
+	}
+	*e = LogEntry{Message: je.Message}
+	if je.Time == "" {
+		return nil
+	}
+	// Python's isoformat omits the timezone for local time.
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02 15:04:05.999999999"} {
+		if t, err := time.ParseInLocation(layout, je.Time, time.Local); err == nil {
+			e.Time = t
+			return nil
+		}
+	}
+	return nil
+}
+
+func (e LogEntry) MarshalJSON() ([]byte, error) {
+	je := jsonLogEntry{Message: e.Message}
+	if !e.Time.IsZero() {
+		je.Time = e.Time.Format(time.RFC3339Nano)
+	}
+	return json.Marshal(je)
+}
@@ -12,6 +12,8 @@ func init() {
 	RegisterEvent[*ExecNode]()
 	RegisterEvent[*ExecNodeDone]()
 	RegisterEvent[*ExecSuccess]()
+	RegisterEvent[*ExecError]()
+	RegisterEvent[*ExecInterrupted]()
 	RegisterEvent[*Progress]()
 	RegisterEvent[*ProgressState]()
 }
@@ -59,7 +61,10 @@ func (*ExecNode) EventType() string {
 }
 
 type NodeOutput struct {
-	Images []types.ImageRef `json:"images"`
+	Images   []types.ImageRef `json:"images,omitempty"`
+	Audio    []types.ImageRef `json:"audio,omitempty"`
+	Text     []string         `json:"text,omitempty"`
+	Animated []bool           `json:"animated,omitempty"`
 }
 
 type ExecNodeDone struct {
@@ -85,7 +90,7 @@ func (*ExecSuccess) EventType() string {
 type ExecError struct {
 	PromptEventBase
 	Time           int64           `json:"timestamp"`
-	Node           NodeID          `json:"node"`
+	Node           NodeID          `json:"node_id"`
 	NodeType       string          `json:"node_type"`
 	Executed       []NodeID        `json:"executed"`
 	Exception      string          `json:"exception_message"`
@@ -99,6 +104,18 @@ func (*ExecError) EventType() string {
 	return "execution_error"
 }
 
+type ExecInterrupted struct {
+	PromptEventBase
+	Time     int64    `json:"timestamp"`
+	Node     NodeID   `json:"node_id"`
+	NodeType string   `json:"node_type"`
+	Executed []NodeID `json:"executed"`
+}
+
+func (*ExecInterrupted) EventType() string {
+	return "execution_interrupted"
+}
+
 type Progress struct {
 	PromptEventBase
 	Node  NodeID  `json:"node"`
@@ -122,8 +139,9 @@ func (*ProgressState) EventType() string {
 type NodeState string
 
 const (
-	StateFinished NodeState = "finished"
+	StatePending  NodeState = "pending"
 	StateRunning  NodeState = "running"
+	StateFinished NodeState = "finished"
 )
 
 type NodeProgress struct {
@@ -43,3 +43,15 @@ type eventMsg[T Event] struct {
 	Type string `json:"type"`
 	Data T      `json:"data"`
 }
+
+// RawMessage is an event encoded as a two-element array, as stored in the prompt history.
+type RawMessage RawEvent
+
+func (m *RawMessage) UnmarshalJSON(data []byte) error {
+	arr := [2]any{&m.Type, &m.Data}
+	return json.Unmarshal(data, &arr)
+}
+
+func (m *RawMessage) Decode() (Event, error) {
+	return (*RawEvent)(m).Decode()
+}